@@ -0,0 +1,21 @@
+data "octopusdeploy_deployments" "production" {
+  project_id      = octopusdeploy_project.web.id
+  environment_ids = [octopusdeploy_environment.production.id]
+}
+
+locals {
+  production_deployments = data.octopusdeploy_deployments.production.deployments
+}
+
+# The version currently live in production
+output "production_version" {
+  value = one([for d in local.production_deployments : d.release_version if d.is_current && d.tenant_id == null])
+}
+
+# Warn while a deployment to production is queued or running
+check "no_running_production_deployment" {
+  assert {
+    condition     = alltrue([for d in local.production_deployments : d.is_completed])
+    error_message = "A deployment to production is in progress."
+  }
+}
@@ -0,0 +1,10 @@
+data "octopusdeploy_deprecations" "project" {
+  resource_type = "octopusdeploy_project"
+}
+
+output "project_deprecations" {
+  value = {
+    for deprecation in data.octopusdeploy_deprecations.project.deprecations :
+    deprecation.attribute => deprecation.message
+  }
+}
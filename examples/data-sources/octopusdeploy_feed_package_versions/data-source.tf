@@ -0,0 +1,19 @@
+# The latest 2.x version of a Helm chart
+data "octopusdeploy_feed_package_versions" "ingress_nginx" {
+  feed_id      = octopusdeploy_helm_feed.ingress_nginx.id
+  package_id   = "ingress-nginx"
+  semver_range = "2.x"
+  latest       = true
+}
+
+# The release candidates of a container image since 1.4, as a channel version rule would find them
+data "octopusdeploy_feed_package_versions" "api_candidates" {
+  feed_id        = octopusdeploy_docker_container_registry.docker_hub.id
+  package_id     = "acme/api"
+  version_range  = "[1.4,)"
+  prerelease_tag = "^rc"
+}
+
+output "ingress_nginx_version" {
+  value = one(data.octopusdeploy_feed_package_versions.ingress_nginx.versions[*].version)
+}
@@ -0,0 +1,33 @@
+resource "octopusdeploy_tentacle_certificate" "agent" {}
+
+resource "octopusdeploy_polling_subscription_id" "agent" {}
+
+resource "octopusdeploy_kubernetes_agent_deployment_target" "agent" {
+  name         = "production-cluster"
+  environments = ["Environments-1"]
+  roles        = ["k8s"]
+  thumbprint   = octopusdeploy_tentacle_certificate.agent.thumbprint
+  uri          = octopusdeploy_polling_subscription_id.agent.polling_uri
+}
+
+data "octopusdeploy_kubernetes_agent_helm_values" "agent" {
+  name             = octopusdeploy_kubernetes_agent_deployment_target.agent.name
+  certificate      = octopusdeploy_tentacle_certificate.agent.base64
+  subscription_uri = octopusdeploy_polling_subscription_id.agent.polling_uri
+}
+
+resource "helm_release" "agent" {
+  name             = data.octopusdeploy_kubernetes_agent_helm_values.agent.release_name
+  namespace        = data.octopusdeploy_kubernetes_agent_helm_values.agent.namespace
+  chart            = data.octopusdeploy_kubernetes_agent_helm_values.agent.chart
+  version          = data.octopusdeploy_kubernetes_agent_helm_values.agent.chart_version
+  create_namespace = true
+  values           = [data.octopusdeploy_kubernetes_agent_helm_values.agent.values]
+}
+
+# An agent that registers itself as a worker when it is installed
+data "octopusdeploy_kubernetes_agent_helm_values" "worker" {
+  name         = "kubernetes-worker"
+  bearer_token = var.bearer_token
+  worker_pools = ["Kubernetes Workers"]
+}
@@ -0,0 +1,12 @@
+data "octopusdeploy_machines" "unhealthy_web_servers" {
+  roles           = ["web"]
+  environment_ids = ["Environments-1"]
+  health_statuses = ["Unhealthy", "Unavailable"]
+}
+
+data "octopusdeploy_machines" "linux_workers" {
+  kind                      = "Worker"
+  worker_pool_ids           = ["WorkerPools-1"]
+  partial_name              = "linux"
+  include_last_health_check = true
+}
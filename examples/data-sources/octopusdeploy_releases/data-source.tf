@@ -0,0 +1,10 @@
+data "octopusdeploy_releases" "latest_1x" {
+  project_id    = octopusdeploy_project.web.id
+  channel_id    = octopusdeploy_channel.stable.id
+  version_range = "[1.0,2.0)"
+  latest        = true
+}
+
+output "latest_1x_version" {
+  value = one(data.octopusdeploy_releases.latest_1x.releases[*].version)
+}
@@ -0,0 +1,8 @@
+data "octopusdeploy_step_template_usage" "outdated" {
+  step_template_id = octopusdeploy_step_template.health_check.id
+  outdated_only    = true
+}
+
+output "outdated_health_check_steps" {
+  value = [for usage in data.octopusdeploy_step_template_usage.outdated.usages : "${usage.project_name}: ${usage.step_name} (version ${usage.version})"]
+}
@@ -0,0 +1,25 @@
+resource "octopusdeploy_tentacle_certificate" "web" {}
+
+resource "octopusdeploy_polling_subscription_id" "web" {}
+
+resource "octopusdeploy_polling_tentacle_deployment_target" "web" {
+  name                              = "web-01"
+  environments                      = ["Environments-1"]
+  roles                             = ["web"]
+  tenanted_deployment_participation = "Untenanted"
+  tentacle_url                      = octopusdeploy_polling_subscription_id.web.polling_uri
+  thumbprint                        = octopusdeploy_tentacle_certificate.web.thumbprint
+}
+
+data "octopusdeploy_tentacle_bootstrap" "web" {
+  name                   = octopusdeploy_polling_tentacle_deployment_target.web.name
+  subscription_id        = octopusdeploy_polling_subscription_id.web.id
+  certificate            = octopusdeploy_tentacle_certificate.web.base64
+  certificate_thumbprint = octopusdeploy_tentacle_certificate.web.thumbprint
+}
+
+# Written into a machine image, e.g. by a Packer build
+resource "local_sensitive_file" "tentacle_config" {
+  filename = "build/tentacle-Tentacle.config"
+  content  = data.octopusdeploy_tentacle_bootstrap.web.linux.tentacle_config
+}
@@ -0,0 +1,12 @@
+data "octopusdeploy_variable_preview" "production" {
+  project_id     = octopusdeploy_project.web.id
+  environment_id = octopusdeploy_environment.production.id
+  tenant_id      = octopusdeploy_tenant.acme.id
+}
+
+check "production_database" {
+  assert {
+    condition     = data.octopusdeploy_variable_preview.production.values["Database.Name"] == "orders-production"
+    error_message = "The production deployment of the web project doesn't use the production database."
+  }
+}
@@ -28,4 +28,10 @@ resource "octopusdeploy_azure_web_app_deployment_target" "static_app" {
     environments = [ octopusdeploy_environment.dev.id, octopusdeploy_environment.prod.id  ]
     web_app_name = "Test Static Web App"
     resource_group_name = "resource-group-name"
-}
\ No newline at end of file
+}
+
+# Copies the project connections, tenant tags and variable values of tenant three, without marking the new tenant as a clone
+resource "octopusdeploy_tenant" "four"{
+    name = "New Tenant Four"
+    template_tenant_id = octopusdeploy_tenant.three.id
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_build_information.<name> <build-information-id>
@@ -0,0 +1,22 @@
+resource "octopusdeploy_package" "web" {
+  source = "${path.module}/dist/web.1.2.3.zip"
+}
+
+resource "octopusdeploy_build_information" "web" {
+  package_id        = octopusdeploy_package.web.package_id
+  version           = octopusdeploy_package.web.version
+  build_environment = "GitHub Actions"
+  build_number      = "42"
+  build_url         = "https://github.com/acme/web/actions/runs/42"
+  branch            = "main"
+  vcs_type          = "Git"
+  vcs_root          = "https://github.com/acme/web"
+  vcs_commit_number = "a1b2c3d"
+
+  commits = [
+    {
+      id      = "a1b2c3d"
+      comment = "Fix the login page, closes #12"
+    },
+  ]
+}
@@ -8,3 +8,16 @@ resource "octopusdeploy_listening_tentacle_deployment_target" "example" {
   tentacle_url                      = "https://example.com:1234/"
   thumbprint                        = "<thumbprint>"
 }
+
+resource "octopusdeploy_listening_tentacle_deployment_target" "healthy" {
+  environments = ["Environments-123"]
+  name         = "Listening Tentacle Deployment Target"
+  roles        = ["web-server"]
+  tentacle_url = "https://example.com:10933/"
+  thumbprint   = "<thumbprint>"
+
+  wait_for_healthy {
+    timeout  = "5m"
+    statuses = ["Healthy"]
+  }
+}
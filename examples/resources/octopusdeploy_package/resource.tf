@@ -0,0 +1,18 @@
+# The package ID and version are read from the file name
+resource "octopusdeploy_package" "bootstrap" {
+  source = "${path.module}/packages/bootstrap.1.0.0.zip"
+}
+
+# A package built by Terraform, with the ID and version set explicitly
+data "archive_file" "config" {
+  type        = "zip"
+  source_dir  = "${path.module}/config"
+  output_path = "${path.module}/build/config.zip"
+}
+
+resource "octopusdeploy_package" "config" {
+  source            = data.archive_file.config.output_path
+  package_id        = "web-config"
+  version           = "1.4.0"
+  delete_on_destroy = true
+}
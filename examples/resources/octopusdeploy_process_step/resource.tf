@@ -73,3 +73,42 @@ resource "octopusdeploy_process_step" "deploy_package" {
     "Octopus.Action.RunOnServer" = "True"    
   }
 }
+
+# Script with a typed block, properties not covered by the block pass through execution_properties
+resource "octopusdeploy_process_step" "typed_script" {
+  process_id  = octopusdeploy_process.example.id
+  name = "Run typed script"
+  type = "Octopus.Script"
+  run_script = {
+    syntax        = "Bash"
+    script_body   = "echo 'Executing step...'"
+    run_on_server = true
+  }
+  execution_properties = {
+    "Octopus.Action.Script.ExtraSetting" = "value"
+  }
+}
+
+# Manual intervention with a typed block
+resource "octopusdeploy_process_step" "typed_approval" {
+  process_id  = octopusdeploy_process.example.id
+  name = "Approve typed deployment"
+  type = "Octopus.Manual"
+  manual_intervention = {
+    instructions      = "Approve the deployment"
+    responsible_teams = ["teams-managers"]
+    block_deployments = true
+  }
+}
+
+# Script loaded from a file next to the configuration
+resource "octopusdeploy_process_step" "script_from_file" {
+  process_id  = octopusdeploy_process.example.id
+  name = "Run script from file"
+  type = "Octopus.Script"
+  script_file = "${path.module}/scripts/deploy.sh"
+  run_script = {
+    syntax        = "Bash"
+    run_on_server = true
+  }
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_project_tenant_connections.<name> <space-id:project-id>
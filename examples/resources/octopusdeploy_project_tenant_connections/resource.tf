@@ -0,0 +1,20 @@
+resource "octopusdeploy_project_tenant_connections" "web" {
+  project_id = "Projects-1"
+
+  # Every tenant tagged Region/EU or Region/UK
+  rule {
+    tenant_tags     = ["Region/EU", "Region/UK"]
+    environment_ids = ["Environments-1", "Environments-2"]
+  }
+
+  # Every gold tier tenant in the US also gets the canary environment
+  rule {
+    tenant_tags     = ["Region/US", "Tier/Gold"]
+    environment_ids = ["Environments-1", "Environments-2", "Environments-3"]
+  }
+
+  tenant {
+    tenant_id       = "Tenants-42"
+    environment_ids = ["Environments-2"]
+  }
+}
@@ -7,3 +7,13 @@ resource "octopusdeploy_script_module" "example" {
     syntax = "PowerShell"
   }
 }
+
+resource "octopusdeploy_script_module" "from_file" {
+  description = "A script module loaded from a file."
+  name        = "Deployment Helpers"
+
+  script {
+    script_file = "${path.module}/modules/DeploymentHelpers.psm1"
+    syntax      = "PowerShell"
+  }
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_tenant_variables.<name> <tenant-id>
@@ -0,0 +1,23 @@
+resource "octopusdeploy_tenant_variables" "acme" {
+  tenant_id = "Tenants-1"
+
+  common_variable {
+    library_variable_set_id = "LibraryVariableSets-1"
+    template_id             = "6c9f2ba3-3ccd-407f-bbdf-6618e4fd0a0c"
+    value                   = "acme.example.com"
+  }
+
+  project_variable {
+    project_id     = "Projects-1"
+    environment_id = "Environments-1"
+    template_id    = "9f2c7e1d-4b8a-4a5e-8d6f-1c2b3a4d5e6f"
+    value          = "Server=test-db;Database=acme"
+  }
+
+  project_variable {
+    project_id     = "Projects-1"
+    environment_id = "Environments-2"
+    template_id    = "9f2c7e1d-4b8a-4a5e-8d6f-1c2b3a4d5e6f"
+    value          = "Server=prod-db;Database=acme"
+  }
+}
@@ -0,0 +1,133 @@
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	CliConfigEnvVar = "OCTOPUS_CLI_CONFIG"
+	ProfileEnvVar   = "OCTOPUS_PROFILE"
+	DefaultProfile  = "default"
+
+	cliConfigDirectory = "octopus"
+	cliConfigFileName  = "cli_config.json"
+)
+
+var spaceIDPattern = regexp.MustCompile(`^Spaces-\d+$`)
+
+// CliProfile holds the connection settings stored by the Octopus CLI.
+type CliProfile struct {
+	Url         string
+	ApiKey      string
+	AccessToken string
+	Space       string
+}
+
+// SpaceID returns the profile space when it is stored as an ID, e.g. Spaces-1.
+func (p *CliProfile) SpaceID() string {
+	if spaceIDPattern.MatchString(p.Space) {
+		return p.Space
+	}
+	return ""
+}
+
+// SpaceName returns the profile space when it is stored as a name.
+func (p *CliProfile) SpaceName() string {
+	if spaceIDPattern.MatchString(p.Space) {
+		return ""
+	}
+	return p.Space
+}
+
+// CliConfigPath returns the location of the Octopus CLI configuration file. OCTOPUS_CLI_CONFIG overrides the
+// default location of <user config dir>/octopus/cli_config.json.
+func CliConfigPath() (string, error) {
+	if path := os.Getenv(CliConfigEnvVar); path != "" {
+		return path, nil
+	}
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, cliConfigDirectory, cliConfigFileName), nil
+}
+
+// LoadCliProfile reads a profile from the Octopus CLI configuration file.
+//
+// The settings written by `octopus login` and `octopus config set` (url, apikey, accesstoken, space) form the "default"
+// profile. The CLI itself only has that one set of settings, so named profiles are specific to the provider: they are
+// kept under a "profiles" object using the same keys, which the CLI ignores.
+func LoadCliProfile(name string) (*CliProfile, error) {
+	path, err := CliConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the Octopus CLI configuration file: %w", err)
+	}
+
+	return ParseCliProfile(contents, name)
+}
+
+func ParseCliProfile(contents []byte, name string) (*CliProfile, error) {
+	var config map[string]any
+	if err := json.Unmarshal(contents, &config); err != nil {
+		return nil, fmt.Errorf("unable to parse the Octopus CLI configuration file: %w", err)
+	}
+	config = lowerCaseKeys(config)
+
+	if name == "" {
+		name = DefaultProfile
+	}
+
+	if profiles, ok := config["profiles"].(map[string]any); ok {
+		for profileName, profile := range profiles {
+			if values, ok := profile.(map[string]any); ok && strings.EqualFold(profileName, name) {
+				return newCliProfile(lowerCaseKeys(values)), nil
+			}
+		}
+	}
+
+	if strings.EqualFold(name, DefaultProfile) {
+		profile := newCliProfile(config)
+		if profile.Url != "" || profile.ApiKey != "" || profile.AccessToken != "" {
+			return profile, nil
+		}
+	}
+
+	return nil, fmt.Errorf("the profile '%s' was not found in the Octopus CLI configuration file", name)
+}
+
+func newCliProfile(values map[string]any) *CliProfile {
+	return &CliProfile{
+		Url:         stringValue(values, "url"),
+		ApiKey:      stringValue(values, "apikey"),
+		AccessToken: stringValue(values, "accesstoken"),
+		Space:       stringValue(values, "space"),
+	}
+}
+
+func stringValue(values map[string]any, key string) string {
+	if value, ok := values[key].(string); ok {
+		return strings.TrimSpace(value)
+	}
+	return ""
+}
+
+// The CLI writes keys through viper, which is case-insensitive, so do not depend on the casing in the file
+func lowerCaseKeys(values map[string]any) map[string]any {
+	result := make(map[string]any, len(values))
+	for key, value := range values {
+		result[strings.ToLower(key)] = value
+	}
+	return result
+}
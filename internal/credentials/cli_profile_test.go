@@ -0,0 +1,90 @@
+package credentials
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cliConfig = `{
+  "Url": "https://octopus.example.com",
+  "ApiKey": "API-DEFAULTKEY",
+  "Space": "Spaces-1",
+  "Profiles": {
+    "Staging": {
+      "url": "https://staging.octopus.example.com",
+      "accesstoken": "eyJhbGciOi",
+      "space": "Platform Team"
+    }
+  }
+}`
+
+// cliLoginConfig is the file written by `octopus login` followed by `octopus config set space`
+const cliLoginConfig = `{
+  "accesstoken": "",
+  "apikey": "API-LOGINKEY",
+  "editor": "",
+  "noprompt": false,
+  "proxyurl": "",
+  "showoctopus": true,
+  "space": "Default",
+  "url": "https://octopus.example.com"
+}`
+
+func TestParseCliProfileWrittenByCliLogin(t *testing.T) {
+	profile, err := ParseCliProfile([]byte(cliLoginConfig), "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://octopus.example.com", profile.Url)
+	assert.Equal(t, "API-LOGINKEY", profile.ApiKey)
+	assert.Equal(t, "", profile.AccessToken)
+	assert.Equal(t, "Default", profile.SpaceName())
+}
+
+func TestParseCliProfileDefault(t *testing.T) {
+	for _, name := range []string{"", "default", "DEFAULT"} {
+		profile, err := ParseCliProfile([]byte(cliConfig), name)
+		require.NoError(t, err)
+
+		assert.Equal(t, "https://octopus.example.com", profile.Url)
+		assert.Equal(t, "API-DEFAULTKEY", profile.ApiKey)
+		assert.Equal(t, "", profile.AccessToken)
+		assert.Equal(t, "Spaces-1", profile.SpaceID())
+		assert.Equal(t, "", profile.SpaceName())
+	}
+}
+
+func TestParseCliProfileNamed(t *testing.T) {
+	profile, err := ParseCliProfile([]byte(cliConfig), "staging")
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://staging.octopus.example.com", profile.Url)
+	assert.Equal(t, "", profile.ApiKey)
+	assert.Equal(t, "eyJhbGciOi", profile.AccessToken)
+	assert.Equal(t, "", profile.SpaceID())
+	assert.Equal(t, "Platform Team", profile.SpaceName())
+}
+
+func TestParseCliProfileMissing(t *testing.T) {
+	_, err := ParseCliProfile([]byte(cliConfig), "production")
+	assert.ErrorContains(t, err, "'production' was not found")
+
+	_, err = ParseCliProfile([]byte(`{}`), "")
+	assert.ErrorContains(t, err, "'default' was not found")
+
+	_, err = ParseCliProfile([]byte(`not json`), "")
+	assert.ErrorContains(t, err, "unable to parse")
+}
+
+func TestLoadCliProfileUsesConfigPathOverride(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cli_config.json")
+	require.NoError(t, os.WriteFile(path, []byte(cliConfig), 0600))
+	t.Setenv(CliConfigEnvVar, path)
+
+	profile, err := LoadCliProfile("Staging")
+	require.NoError(t, err)
+	assert.Equal(t, "https://staging.octopus.example.com", profile.Url)
+}
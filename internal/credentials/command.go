@@ -0,0 +1,207 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+)
+
+const (
+	commandTimeout = time.Minute
+	// Tokens are refreshed slightly before they expire so requests in flight do not race the expiry
+	refreshWindow = time.Minute
+)
+
+// commandOutput is the JSON document a credential helper writes to stdout. Exactly one of access_token or
+// api_key must be set. The expiry is optional, and either an absolute expires_at or a relative expires_in.
+type commandOutput struct {
+	AccessToken string `json:"access_token"`
+	ApiKey      string `json:"api_key"`
+	ExpiresAt   string `json:"expires_at"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+type token struct {
+	credential client.ICredential
+	expiresAt  time.Time
+}
+
+func (t *token) valid(now time.Time) bool {
+	return t != nil && (t.expiresAt.IsZero() || now.Add(refreshWindow).Before(t.expiresAt))
+}
+
+// CommandCredential obtains credentials by running an external helper, and runs it again once the
+// returned token is about to expire.
+type CommandCredential struct {
+	command []string
+
+	mu    sync.Mutex
+	token *token
+
+	// run and now are replaced in tests
+	run func(ctx context.Context, command []string) ([]byte, error)
+	now func() time.Time
+}
+
+var _ client.ICredential = (*CommandCredential)(nil)
+
+// Both the plugin framework and the SDKv2 halves of the provider are configured with the same command,
+// so share helpers across them rather than running the command twice.
+var commandCredentials = struct {
+	sync.Mutex
+	byCommand map[string]*CommandCredential
+}{byCommand: map[string]*CommandCredential{}}
+
+func NewCommandCredential(command []string) *CommandCredential {
+	key := strings.Join(command, "\x00")
+
+	commandCredentials.Lock()
+	defer commandCredentials.Unlock()
+
+	if existing, ok := commandCredentials.byCommand[key]; ok {
+		return existing
+	}
+
+	credential := &CommandCredential{
+		command: command,
+		run:     runCommand,
+		now:     time.Now,
+	}
+	commandCredentials.byCommand[key] = credential
+	return credential
+}
+
+// Refresh returns the current credential, running the helper when there is no token or it is about to expire.
+func (c *CommandCredential) Refresh(ctx context.Context) (client.ICredential, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token.valid(c.now()) {
+		return c.token.credential, nil
+	}
+
+	output, err := c.run(ctx, c.command)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshed, err := parseCommandOutput(output, c.now())
+	if err != nil {
+		return nil, fmt.Errorf("the credential command '%s' returned an invalid response: %w", c.command[0], err)
+	}
+
+	c.token = refreshed
+	return refreshed.credential, nil
+}
+
+// GetHeaderValue implements client.ICredential. Errors are not reported here; the previous token is
+// used instead and the server rejects it if it has expired.
+func (c *CommandCredential) GetHeaderValue() (string, string) {
+	credential, err := c.Refresh(context.Background())
+	if err != nil {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.token == nil {
+			return "Authorization", ""
+		}
+		return c.token.credential.GetHeaderValue()
+	}
+
+	return credential.GetHeaderValue()
+}
+
+// Transport sets the current credential header on every request. The Octopus client only reads the
+// credential once when it is created, so this is what allows tokens to be refreshed.
+func (c *CommandCredential) Transport(base http.RoundTripper) http.RoundTripper {
+	return &commandCredentialTransport{base: base, credential: c}
+}
+
+type commandCredentialTransport struct {
+	base       http.RoundTripper
+	credential *CommandCredential
+}
+
+func (t *commandCredentialTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	credential, err := t.credential.Refresh(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	outgoing := req.Clone(req.Context())
+	outgoing.Header.Del("Authorization")
+	outgoing.Header.Del("X-Octopus-ApiKey")
+
+	key, value := credential.GetHeaderValue()
+	outgoing.Header.Set(key, value)
+
+	return t.base.RoundTrip(outgoing)
+}
+
+func parseCommandOutput(output []byte, now time.Time) (*token, error) {
+	var response commandOutput
+	if err := json.Unmarshal(bytes.TrimSpace(output), &response); err != nil {
+		return nil, err
+	}
+
+	result := &token{}
+
+	switch {
+	case response.AccessToken != "" && response.ApiKey != "":
+		return nil, fmt.Errorf("only one of access_token or api_key can be returned")
+	case response.AccessToken != "":
+		accessToken, err := client.NewAccessToken(response.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+		result.credential = accessToken
+	case response.ApiKey != "":
+		apiKey, err := client.NewApiKey(response.ApiKey)
+		if err != nil {
+			return nil, err
+		}
+		result.credential = apiKey
+	default:
+		return nil, fmt.Errorf("either access_token or api_key must be returned")
+	}
+
+	switch {
+	case response.ExpiresAt != "":
+		expiresAt, err := time.Parse(time.RFC3339, response.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("expires_at must be an RFC 3339 timestamp: %w", err)
+		}
+		result.expiresAt = expiresAt
+	case response.ExpiresIn > 0:
+		result.expiresAt = now.Add(time.Duration(response.ExpiresIn) * time.Second)
+	}
+
+	return result, nil
+}
+
+func runCommand(ctx context.Context, command []string) ([]byte, error) {
+	if len(command) == 0 || command[0] == "" {
+		return nil, fmt.Errorf("the credential command must not be empty")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, commandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("the credential command '%s' failed: %w: %s", command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.Bytes(), nil
+}
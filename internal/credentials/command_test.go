@@ -0,0 +1,119 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommandOutput(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	accessToken, err := parseCommandOutput([]byte(`{"access_token": "token-value", "expires_at": "2026-01-02T04:04:05Z"}`), now)
+	require.NoError(t, err)
+	key, value := accessToken.credential.GetHeaderValue()
+	assert.Equal(t, "Authorization", key)
+	assert.Equal(t, "Bearer token-value", value)
+	assert.Equal(t, now.Add(time.Hour), accessToken.expiresAt)
+
+	apiKey, err := parseCommandOutput([]byte(`{"api_key": "API-ABCDEFGHIJKLMNOPQRSTUVWXYZ", "expires_in": 600}`), now)
+	require.NoError(t, err)
+	key, value = apiKey.credential.GetHeaderValue()
+	assert.Equal(t, "X-Octopus-ApiKey", key)
+	assert.Equal(t, "API-ABCDEFGHIJKLMNOPQRSTUVWXYZ", value)
+	assert.Equal(t, now.Add(10*time.Minute), apiKey.expiresAt)
+
+	noExpiry, err := parseCommandOutput([]byte(`{"access_token": "token-value"}`), now)
+	require.NoError(t, err)
+	assert.True(t, noExpiry.expiresAt.IsZero())
+}
+
+func TestParseCommandOutputErrors(t *testing.T) {
+	now := time.Now()
+
+	for _, output := range []string{
+		`{}`,
+		`{"access_token": "a", "api_key": "API-ABCDEFGHIJKLMNOPQRSTUVWXYZ"}`,
+		`{"api_key": "not-an-api-key"}`,
+		`{"access_token": "a", "expires_at": "tomorrow"}`,
+		`token`,
+	} {
+		_, err := parseCommandOutput([]byte(output), now)
+		assert.Error(t, err, output)
+	}
+}
+
+func TestCommandCredentialRefreshesExpiredTokens(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	runs := 0
+
+	credential := &CommandCredential{
+		command: []string{"octopus-login"},
+		now:     func() time.Time { return now },
+		run: func(ctx context.Context, command []string) ([]byte, error) {
+			runs++
+			return []byte(fmt.Sprintf(`{"access_token": "token-%d", "expires_in": 300}`, runs)), nil
+		},
+	}
+
+	_, value := credential.GetHeaderValue()
+	assert.Equal(t, "Bearer token-1", value)
+
+	now = now.Add(2 * time.Minute)
+	_, value = credential.GetHeaderValue()
+	assert.Equal(t, "Bearer token-1", value)
+
+	// Within the refresh window of the expiry
+	now = now.Add(2*time.Minute + 30*time.Second)
+	_, value = credential.GetHeaderValue()
+	assert.Equal(t, "Bearer token-2", value)
+	assert.Equal(t, 2, runs)
+}
+
+func TestCommandCredentialTransportReplacesCredentialHeaders(t *testing.T) {
+	var authorization, apiKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		apiKey = r.Header.Get("X-Octopus-ApiKey")
+	}))
+	defer server.Close()
+
+	credential := &CommandCredential{
+		command: []string{"octopus-login"},
+		now:     time.Now,
+		run: func(ctx context.Context, command []string) ([]byte, error) {
+			return []byte(`{"access_token": "fresh"}`), nil
+		},
+	}
+
+	request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	request.Header.Set("Authorization", "Bearer stale")
+	request.Header.Set("X-Octopus-ApiKey", "API-STALE")
+
+	response, err := credential.Transport(http.DefaultTransport).RoundTrip(request)
+	require.NoError(t, err)
+	response.Body.Close()
+
+	assert.Equal(t, "Bearer fresh", authorization)
+	assert.Equal(t, "", apiKey)
+	assert.Equal(t, "Bearer stale", request.Header.Get("Authorization"))
+}
+
+func TestRunCommand(t *testing.T) {
+	output, err := runCommand(context.Background(), []string{"sh", "-c", `echo '{"access_token": "abc"}'`})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"access_token": "abc"}`, string(output))
+
+	_, err = runCommand(context.Background(), []string{"sh", "-c", "echo denied >&2; exit 3"})
+	assert.ErrorContains(t, err, "denied")
+
+	_, err = runCommand(context.Background(), []string{})
+	assert.Error(t, err)
+}
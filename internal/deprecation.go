@@ -42,3 +42,25 @@ func IsDeprecatedResourceEnabled(deprecationKey string) bool {
 func GetDeprecatedResourceError(resourceName, deprecationKey string) error {
 	return fmt.Errorf("the '%s' resource is deprecated and disabled. This resource will be permanently removed in a future version. To temporarily enable it, set the environment variable TF_OCTOPUS_DEPRECATION_REVERSALS=%s", resourceName, deprecationKey)
 }
+
+// GetDeprecatedAttributeMessage describes a deprecated attribute or block, naming the attribute to use instead and
+// the provider version it will be removed in. Attributes with a reversal key are disabled unless the key is listed in
+// TF_OCTOPUS_DEPRECATION_REVERSALS.
+func GetDeprecatedAttributeMessage(replacement, removalVersion, deprecationKey string) string {
+	message := "Deprecated"
+	if replacement != "" {
+		message += fmt.Sprintf(", use '%s' instead", replacement)
+	}
+	message += "."
+	if removalVersion != "" {
+		message += fmt.Sprintf(" It will be removed in version %s of the provider.", removalVersion)
+	}
+	if deprecationKey != "" {
+		message += fmt.Sprintf(" It is disabled unless %s=%s is set.", DeprecationReversalsEnvVar, deprecationKey)
+	}
+	return message
+}
+
+func GetDeprecatedAttributeError(attributeName, deprecationKey string) error {
+	return fmt.Errorf("the '%s' attribute is deprecated and disabled. This attribute will be permanently removed in a future version. To temporarily enable it, set the environment variable TF_OCTOPUS_DEPRECATION_REVERSALS=%s", attributeName, deprecationKey)
+}
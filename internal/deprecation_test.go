@@ -100,3 +100,55 @@ func containsMiddle(s, substr string) bool {
 	}
 	return false
 }
+
+func TestGetDeprecatedAttributeMessage(t *testing.T) {
+	tests := []struct {
+		name           string
+		replacement    string
+		removalVersion string
+		deprecationKey string
+		expected       string
+	}{
+		{
+			name:     "no details",
+			expected: "Deprecated.",
+		},
+		{
+			name:           "replacement and removal version",
+			replacement:    "is_discrete_channel_release",
+			removalVersion: "2.0.0",
+			expected:       "Deprecated, use 'is_discrete_channel_release' instead. It will be removed in version 2.0.0 of the provider.",
+		},
+		{
+			name:           "reversal key",
+			replacement:    "owner_id",
+			deprecationKey: "octopusdeploy_variable.project_id",
+			expected:       "Deprecated, use 'owner_id' instead. It is disabled unless TF_OCTOPUS_DEPRECATION_REVERSALS=octopusdeploy_variable.project_id is set.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := GetDeprecatedAttributeMessage(tt.replacement, tt.removalVersion, tt.deprecationKey)
+			if result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestGetDeprecatedAttributeError(t *testing.T) {
+	err := GetDeprecatedAttributeError("discrete_channel_release", "Test_v1.0.0")
+
+	expectedSubstrings := []string{
+		"'discrete_channel_release' attribute",
+		"deprecated and disabled",
+		"TF_OCTOPUS_DEPRECATION_REVERSALS=Test_v1.0.0",
+	}
+
+	for _, substr := range expectedSubstrings {
+		if !contains(err.Error(), substr) {
+			t.Errorf("expected error message to contain %q, got: %s", substr, err.Error())
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+)
+
+const (
+	StatusHasWarnings = "HasWarnings"
+	StatusHealthy     = "Healthy"
+	StatusUnavailable = "Unavailable"
+	StatusUnhealthy   = "Unhealthy"
+	StatusUnknown     = "Unknown"
+
+	DefaultTimeout = "10m"
+)
+
+// Statuses are the health statuses of a deployment target or worker.
+var Statuses = []string{StatusHasWarnings, StatusHealthy, StatusUnavailable, StatusUnhealthy, StatusUnknown}
+
+// DefaultAcceptedStatuses are the health statuses a machine can be used in deployments with.
+var DefaultAcceptedStatuses = []string{StatusHealthy, StatusHasWarnings}
+
+// pollInterval is how often the machine and the health check task are read while waiting.
+var pollInterval = 5 * time.Second
+
+// WaitOptions configures waiting for a deployment target or worker to become healthy.
+type WaitOptions struct {
+	Timeout          time.Duration
+	AcceptedStatuses []string
+}
+
+// MachineStatus is the health of a deployment target or worker.
+type MachineStatus struct {
+	HealthStatus  string
+	StatusSummary string
+}
+
+// Check runs a health check on a single deployment target or worker.
+type Check interface {
+	// Start queues the health check task and returns its ID.
+	Start() (string, error)
+	// Task returns the health check task.
+	Task(taskID string) (*tasks.Task, error)
+	// Status returns the current health of the machine.
+	Status() (MachineStatus, error)
+}
+
+type machineCheck struct {
+	client    *client.Client
+	spaceID   string
+	machineID string
+	name      string
+	status    func() (MachineStatus, error)
+}
+
+// NewMachineCheck returns a health check of the deployment target or worker. The status function reads the machine,
+// as deployment targets and workers are read with different APIs.
+func NewMachineCheck(octopus *client.Client, spaceID string, machineID string, name string, status func() (MachineStatus, error)) Check {
+	return &machineCheck{
+		client:    octopus,
+		spaceID:   spaceID,
+		machineID: machineID,
+		name:      name,
+		status:    status,
+	}
+}
+
+func (c *machineCheck) Start() (string, error) {
+	task := tasks.NewTask()
+	task.Name = "Health"
+	task.Description = fmt.Sprintf("Check health of %s", c.name)
+	task.SpaceID = c.spaceID
+	task.Arguments["Timeout"] = "00:05:00"
+	task.Arguments["MachineTimeout"] = "00:05:00"
+	task.Arguments["MachineIds"] = []string{c.machineID}
+
+	createdTask, err := c.client.Tasks.Add(task)
+	if err != nil {
+		return "", fmt.Errorf("unable to start a health check of %s: %w", c.name, err)
+	}
+	return createdTask.GetID(), nil
+}
+
+func (c *machineCheck) Task(taskID string) (*tasks.Task, error) {
+	found, err := c.client.Tasks.Get(tasks.TasksQuery{IDs: []string{taskID}, Take: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(found.Items) == 0 {
+		return nil, fmt.Errorf("health check task %s not found", taskID)
+	}
+	return found.Items[0], nil
+}
+
+func (c *machineCheck) Status() (MachineStatus, error) {
+	return c.status()
+}
+
+// WaitForHealthy starts a health check and waits for it to complete, then checks that the machine reached one of the
+// accepted health statuses. It returns an error when the machine has any other status, or the timeout is reached first.
+func WaitForHealthy(ctx context.Context, check Check, options WaitOptions) error {
+	accepted := options.AcceptedStatuses
+	if len(accepted) == 0 {
+		accepted = DefaultAcceptedStatuses
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, options.Timeout)
+	defer cancel()
+
+	taskID, err := check.Start()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		task, err := check.Task(taskID)
+		if err != nil {
+			return fmt.Errorf("unable to read health check task %s: %w", taskID, err)
+		}
+
+		// the machine is only read once the task completes, as until then its status is from an earlier health check
+		if task.IsCompleted != nil && *task.IsCompleted {
+			status, err := check.Status()
+			if err != nil {
+				return err
+			}
+
+			if slices.Contains(accepted, status.HealthStatus) {
+				return nil
+			}
+
+			return fmt.Errorf("health check task %s completed with health status '%s', expected one of '%s'%s",
+				taskID, status.HealthStatus, strings.Join(accepted, "', '"), describe(status, task))
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %s waiting for health check task %s to complete%s",
+				options.Timeout, taskID, describe(MachineStatus{}, task))
+		case <-ticker.C:
+		}
+	}
+}
+
+func describe(status MachineStatus, task *tasks.Task) string {
+	var details []string
+	if status.StatusSummary != "" {
+		details = append(details, status.StatusSummary)
+	}
+	if task.ErrorMessage != "" {
+		details = append(details, task.ErrorMessage)
+	}
+	if len(details) == 0 {
+		return ""
+	}
+	return ": " + strings.Join(details, " ")
+}
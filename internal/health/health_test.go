@@ -0,0 +1,116 @@
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCheck completes the task after it has been read the given number of times. The machine has the stale status
+// until then, and the checked status after.
+type fakeCheck struct {
+	pendingReads int
+	staleStatus  string
+	status       string
+	errorMessage string
+	taskReads    int
+}
+
+func (f *fakeCheck) Start() (string, error) {
+	return "ServerTasks-1", nil
+}
+
+func (f *fakeCheck) Task(string) (*tasks.Task, error) {
+	f.taskReads++
+	completed := f.completed()
+	task := tasks.NewTask()
+	task.IsCompleted = &completed
+	task.ErrorMessage = f.errorMessage
+	return task, nil
+}
+
+func (f *fakeCheck) Status() (MachineStatus, error) {
+	status := f.staleStatus
+	if f.completed() {
+		status = f.status
+	}
+	return MachineStatus{HealthStatus: status, StatusSummary: "summary of " + status}, nil
+}
+
+func (f *fakeCheck) completed() bool {
+	return f.taskReads > f.pendingReads
+}
+
+func TestWaitForHealthy(t *testing.T) {
+	pollInterval = time.Millisecond
+
+	tests := []struct {
+		name     string
+		check    fakeCheck
+		accepted []string
+		errorMsg string
+	}{
+		{
+			name:  "healthy once the check completes",
+			check: fakeCheck{pendingReads: 2, staleStatus: StatusUnknown, status: StatusHealthy},
+		},
+		{
+			name:  "warnings are accepted by default",
+			check: fakeCheck{pendingReads: 1, staleStatus: StatusUnknown, status: StatusHasWarnings},
+		},
+		{
+			name:     "warnings are not accepted when only healthy is",
+			check:    fakeCheck{pendingReads: 1, staleStatus: StatusUnknown, status: StatusHasWarnings},
+			accepted: []string{StatusHealthy},
+			errorMsg: "completed with health status 'HasWarnings', expected one of 'Healthy'",
+		},
+		{
+			name:     "unavailable machines fail when the check completes",
+			check:    fakeCheck{pendingReads: 1, staleStatus: StatusUnknown, status: StatusUnavailable},
+			errorMsg: "summary of Unavailable",
+		},
+		{
+			name:     "a healthy status from an earlier check is not accepted",
+			check:    fakeCheck{pendingReads: 3, staleStatus: StatusHealthy, status: StatusUnavailable},
+			errorMsg: "completed with health status 'Unavailable'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := tt.check
+			err := WaitForHealthy(context.Background(), &check, WaitOptions{Timeout: time.Minute, AcceptedStatuses: tt.accepted})
+			assert.Equal(t, check.pendingReads+1, check.taskReads, "the task should be read until it completes")
+			if tt.errorMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorMsg)
+		})
+	}
+}
+
+func TestWaitForHealthyTimesOut(t *testing.T) {
+	pollInterval = time.Millisecond
+
+	check := &fakeCheck{pendingReads: 1000000, staleStatus: StatusHealthy, status: StatusHealthy}
+	err := WaitForHealthy(context.Background(), check, WaitOptions{Timeout: 20 * time.Millisecond})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out after 20ms waiting for health check task ServerTasks-1 to complete")
+}
+
+func TestValidateTimeout(t *testing.T) {
+	_, errs := ValidateTimeout("5m", "timeout")
+	assert.Empty(t, errs)
+
+	_, errs = ValidateTimeout("five minutes", "timeout")
+	assert.Len(t, errs, 1)
+
+	_, errs = ValidateTimeout("0s", "timeout")
+	assert.Len(t, errs, 1)
+}
@@ -0,0 +1,92 @@
+package health
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	WaitForHealthyDescription         = "Waits for the machine to connect after it is created or its connection settings change. A health check is started and the resource waits for it to complete, then fails unless the machine has one of the accepted health statuses."
+	WaitForHealthyTimeoutDescription  = "How long to wait for the machine to become healthy, as a duration such as `5m` or `1h`. Defaults to `" + DefaultTimeout + "`."
+	WaitForHealthyStatusesDescription = "The health statuses that count as healthy. Defaults to `Healthy` and `HasWarnings`."
+)
+
+// GetWaitForHealthySchema returns the SDKv2 schema of the wait_for_healthy block.
+func GetWaitForHealthySchema() *schema.Schema {
+	return &schema.Schema{
+		Description: WaitForHealthyDescription,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timeout": {
+					Default:          DefaultTimeout,
+					Description:      WaitForHealthyTimeoutDescription,
+					Optional:         true,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(ValidateTimeout),
+				},
+				"statuses": {
+					Description: WaitForHealthyStatusesDescription,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(Statuses, false)),
+					},
+					Optional: true,
+					Type:     schema.TypeSet,
+				},
+			},
+		},
+		MaxItems: 1,
+		Optional: true,
+		Type:     schema.TypeList,
+	}
+}
+
+// ExpandWaitForHealthy returns the options of the wait_for_healthy block, or nil when it is not set.
+func ExpandWaitForHealthy(v interface{}) (*WaitOptions, error) {
+	values, ok := v.([]interface{})
+	if !ok || len(values) == 0 {
+		return nil, nil
+	}
+
+	options := &WaitOptions{}
+	valuesMap, _ := values[0].(map[string]interface{})
+
+	timeout, _ := valuesMap["timeout"].(string)
+	if timeout == "" {
+		timeout = DefaultTimeout
+	}
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid wait_for_healthy timeout '%s': %w", timeout, err)
+	}
+	options.Timeout = duration
+
+	if statuses, ok := valuesMap["statuses"].(*schema.Set); ok {
+		for _, status := range statuses.List() {
+			options.AcceptedStatuses = append(options.AcceptedStatuses, status.(string))
+		}
+	}
+
+	return options, nil
+}
+
+// ValidateTimeout checks the timeout is a positive duration.
+func ValidateTimeout(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a duration such as 5m, got %s: %w", k, v, err)}
+	}
+	if duration <= 0 {
+		return nil, []error{fmt.Errorf("expected %s to be a positive duration, got %s", k, v)}
+	}
+
+	return nil, nil
+}
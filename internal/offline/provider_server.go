@@ -0,0 +1,133 @@
+package offline
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// providerServer handles provider configurations that are not known until apply, e.g. when the Octopus Server
+// is created in the same configuration, and Terraform does not support deferred actions.
+//
+// The provider is then configured without a connection. Resources can still be planned, but there is no server
+// to refresh existing resources against, read data sources from or import from, so those requests are answered
+// here instead of reaching resources without a client.
+type providerServer struct {
+	tfprotov6.ProviderServer
+
+	unknown atomic.Bool
+}
+
+var _ tfprotov6.ProviderServer = (*providerServer)(nil)
+var _ tfprotov6.ResourceServerWithMoveResourceState = (*providerServer)(nil)
+
+func NewProviderServer(server tfprotov6.ProviderServer) tfprotov6.ProviderServer {
+	return &providerServer{ProviderServer: server}
+}
+
+func (s *providerServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	unknown, err := s.isConfigurationUnknown(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	deferralAllowed := req.ClientCapabilities != nil && req.ClientCapabilities.DeferralAllowed
+	s.unknown.Store(unknown && !deferralAllowed)
+
+	resp, err := s.ProviderServer.ConfigureProvider(ctx, req)
+	if err != nil || resp == nil || !s.unknown.Load() {
+		return resp, err
+	}
+
+	resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityWarning,
+		Summary:  "Provider configuration is not known until apply",
+		Detail: "The address, credentials or space of the Octopus Deploy provider depend on values that are not known yet, " +
+			"so the provider is not connected to an Octopus Server during this plan. Existing resources are not refreshed " +
+			"and server version and feature checks are skipped. Use a version of Terraform that supports deferred actions, " +
+			"or apply the resources the provider configuration depends on first.",
+	})
+
+	return resp, nil
+}
+
+func (s *providerServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	if !s.unknown.Load() {
+		return s.ProviderServer.ReadResource(ctx, req)
+	}
+
+	return &tfprotov6.ReadResourceResponse{
+		NewState: req.CurrentState,
+		Private:  req.Private,
+	}, nil
+}
+
+func (s *providerServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	if !s.unknown.Load() {
+		return s.ProviderServer.ReadDataSource(ctx, req)
+	}
+
+	return &tfprotov6.ReadDataSourceResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{unknownConfigurationError(req.TypeName, "read")},
+	}, nil
+}
+
+func (s *providerServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	if !s.unknown.Load() {
+		return s.ProviderServer.ImportResourceState(ctx, req)
+	}
+
+	return &tfprotov6.ImportResourceStateResponse{
+		Diagnostics: []*tfprotov6.Diagnostic{unknownConfigurationError(req.TypeName, "imported")},
+	}, nil
+}
+
+func (s *providerServer) MoveResourceState(ctx context.Context, req *tfprotov6.MoveResourceStateRequest) (*tfprotov6.MoveResourceStateResponse, error) {
+	server, ok := s.ProviderServer.(tfprotov6.ResourceServerWithMoveResourceState)
+	if !ok {
+		return &tfprotov6.MoveResourceStateResponse{
+			Diagnostics: []*tfprotov6.Diagnostic{{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "MoveResourceState Not Implemented",
+				Detail:   "The provider server does not support moving resource state.",
+			}},
+		}, nil
+	}
+
+	return server.MoveResourceState(ctx, req)
+}
+
+// isConfigurationUnknown decodes the provider configuration with the provider schema, as the configuration is
+// shared by the plugin framework and SDKv2 halves of the provider.
+func (s *providerServer) isConfigurationUnknown(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (bool, error) {
+	if req.Config == nil {
+		return false, nil
+	}
+
+	schema, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return false, err
+	}
+	if schema == nil || schema.Provider == nil {
+		return false, nil
+	}
+
+	config, err := req.Config.Unmarshal(schema.Provider.ValueType())
+	if err != nil {
+		return false, fmt.Errorf("unable to decode the provider configuration: %w", err)
+	}
+
+	return !config.IsFullyKnown(), nil
+}
+
+func unknownConfigurationError(typeName string, action string) *tfprotov6.Diagnostic {
+	return &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "Provider configuration is not known until apply",
+		Detail: fmt.Sprintf("%s cannot be %s because the address, credentials or space of the Octopus Deploy provider "+
+			"depend on values that are not known yet. Use a version of Terraform that supports deferred actions, or apply "+
+			"the resources the provider configuration depends on first.", typeName, action),
+	}
+}
@@ -0,0 +1,121 @@
+package offline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var providerType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"address": tftypes.String,
+	"api_key": tftypes.String,
+}}
+
+// fakeProviderServer records which requests reach the wrapped provider.
+type fakeProviderServer struct {
+	tfprotov6.ProviderServer
+
+	reads       int
+	dataReads   int
+	importCalls int
+}
+
+func (f *fakeProviderServer) GetProviderSchema(context.Context, *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	return &tfprotov6.GetProviderSchemaResponse{
+		Provider: &tfprotov6.Schema{Block: &tfprotov6.SchemaBlock{Attributes: []*tfprotov6.SchemaAttribute{
+			{Name: "address", Type: tftypes.String, Optional: true},
+			{Name: "api_key", Type: tftypes.String, Optional: true},
+		}}},
+	}, nil
+}
+
+func (f *fakeProviderServer) ConfigureProvider(context.Context, *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	return &tfprotov6.ConfigureProviderResponse{}, nil
+}
+
+func (f *fakeProviderServer) ReadResource(_ context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	f.reads++
+	return &tfprotov6.ReadResourceResponse{NewState: req.CurrentState}, nil
+}
+
+func (f *fakeProviderServer) ReadDataSource(context.Context, *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	f.dataReads++
+	return &tfprotov6.ReadDataSourceResponse{}, nil
+}
+
+func (f *fakeProviderServer) ImportResourceState(context.Context, *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	f.importCalls++
+	return &tfprotov6.ImportResourceStateResponse{}, nil
+}
+
+func TestUnknownConfigurationSkipsServerRequests(t *testing.T) {
+	fake := &fakeProviderServer{}
+	server := NewProviderServer(fake)
+
+	resp, err := server.ConfigureProvider(context.Background(), configureRequest(t, tftypes.UnknownValue, false))
+	require.NoError(t, err)
+	require.Len(t, resp.Diagnostics, 1)
+	assert.Equal(t, tfprotov6.DiagnosticSeverityWarning, resp.Diagnostics[0].Severity)
+
+	state := &tfprotov6.DynamicValue{MsgPack: []byte{0x80}}
+	readResp, err := server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{TypeName: "octopusdeploy_project", CurrentState: state})
+	require.NoError(t, err)
+	assert.Equal(t, state, readResp.NewState)
+
+	dataResp, err := server.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{TypeName: "octopusdeploy_projects"})
+	require.NoError(t, err)
+	require.Len(t, dataResp.Diagnostics, 1)
+	assert.Equal(t, tfprotov6.DiagnosticSeverityError, dataResp.Diagnostics[0].Severity)
+	assert.Contains(t, dataResp.Diagnostics[0].Detail, "octopusdeploy_projects cannot be read")
+
+	importResp, err := server.ImportResourceState(context.Background(), &tfprotov6.ImportResourceStateRequest{TypeName: "octopusdeploy_project"})
+	require.NoError(t, err)
+	require.Len(t, importResp.Diagnostics, 1)
+
+	assert.Zero(t, fake.reads)
+	assert.Zero(t, fake.dataReads)
+	assert.Zero(t, fake.importCalls)
+}
+
+func TestUnknownConfigurationIsLeftToTheProviderWhenDeferralIsAllowed(t *testing.T) {
+	fake := &fakeProviderServer{}
+	server := NewProviderServer(fake)
+
+	resp, err := server.ConfigureProvider(context.Background(), configureRequest(t, tftypes.UnknownValue, true))
+	require.NoError(t, err)
+	assert.Empty(t, resp.Diagnostics)
+
+	_, err = server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{TypeName: "octopusdeploy_project"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, fake.reads)
+}
+
+func TestKnownConfigurationPassesThrough(t *testing.T) {
+	fake := &fakeProviderServer{}
+	server := NewProviderServer(fake)
+
+	resp, err := server.ConfigureProvider(context.Background(), configureRequest(t, "https://octopus.example.com", false))
+	require.NoError(t, err)
+	assert.Empty(t, resp.Diagnostics)
+
+	_, err = server.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{TypeName: "octopusdeploy_projects"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, fake.dataReads)
+}
+
+func configureRequest(t *testing.T, address any, deferralAllowed bool) *tfprotov6.ConfigureProviderRequest {
+	config, err := tfprotov6.NewDynamicValue(providerType, tftypes.NewValue(providerType, map[string]tftypes.Value{
+		"address": tftypes.NewValue(tftypes.String, address),
+		"api_key": tftypes.NewValue(tftypes.String, "API-XXXXXXXXXXXXXXXXXXXXXXXXXXXX"),
+	}))
+	require.NoError(t, err)
+
+	return &tfprotov6.ConfigureProviderRequest{
+		Config:             &config,
+		ClientCapabilities: &tfprotov6.ConfigureProviderClientCapabilities{DeferralAllowed: deferralAllowed},
+	}
+}
@@ -0,0 +1,75 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	AttributeResourceType = "terraform.resource.type"
+	AttributeOperation    = "terraform.operation"
+)
+
+// The Octopus client does not accept a context, so outgoing requests cannot carry the span of the operation
+// that issued them. In-flight operations are tracked here so the HTTP transport can still find a parent.
+var activeOperations = struct {
+	sync.Mutex
+	spans map[trace.SpanID]trace.Span
+}{spans: map[trace.SpanID]trace.Span{}}
+
+// StartOperation starts a span for a single Terraform operation (create, read, update, delete, plan, import)
+// against a resource or data source. The returned function ends the span and must be called exactly once.
+func StartOperation(ctx context.Context, typeName string, operation string) (context.Context, func(err error)) {
+	ctx, span := Tracer().Start(ctx, typeName+" "+operation, trace.WithAttributes(
+		attribute.String(AttributeResourceType, typeName),
+		attribute.String(AttributeOperation, operation),
+	))
+
+	spanID := span.SpanContext().SpanID()
+	if span.IsRecording() {
+		activeOperations.Lock()
+		activeOperations.spans[spanID] = span
+		activeOperations.Unlock()
+	}
+
+	return ctx, func(err error) {
+		activeOperations.Lock()
+		delete(activeOperations.spans, spanID)
+		activeOperations.Unlock()
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+// parentContext picks the parent for an outgoing request: the span on the request context when there is one,
+// otherwise the only in-flight operation. When several operations run in parallel the request is parented to
+// the provider span and linked to every candidate operation.
+func parentContext(ctx context.Context) (context.Context, []trace.Link) {
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx, nil
+	}
+
+	activeOperations.Lock()
+	defer activeOperations.Unlock()
+
+	if len(activeOperations.spans) == 1 {
+		for _, span := range activeOperations.spans {
+			return trace.ContextWithSpan(ctx, span), nil
+		}
+	}
+
+	links := make([]trace.Link, 0, len(activeOperations.spans))
+	for _, span := range activeOperations.spans {
+		links = append(links, trace.Link{SpanContext: span.SpanContext()})
+	}
+
+	return trace.ContextWithSpan(ctx, trace.SpanFromContext(rootContext)), links
+}
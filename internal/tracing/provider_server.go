@@ -0,0 +1,128 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+const (
+	OperationConfigure = "configure"
+	OperationPlan      = "plan"
+	OperationCreate    = "create"
+	OperationRead      = "read"
+	OperationUpdate    = "update"
+	OperationDelete    = "delete"
+	OperationImport    = "import"
+)
+
+// providerServer wraps the muxed provider so every resource and data source operation, whether served by the
+// plugin framework or SDKv2, is recorded as a span.
+type providerServer struct {
+	tfprotov6.ProviderServer
+}
+
+var _ tfprotov6.ProviderServer = (*providerServer)(nil)
+var _ tfprotov6.ResourceServerWithMoveResourceState = (*providerServer)(nil)
+
+func NewProviderServer(server tfprotov6.ProviderServer) tfprotov6.ProviderServer {
+	return &providerServer{ProviderServer: server}
+}
+
+func (s *providerServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	ctx, end := StartOperation(ctx, "provider", OperationConfigure)
+	resp, err := s.ProviderServer.ConfigureProvider(ctx, req)
+	end(operationError(err, resp, func(r *tfprotov6.ConfigureProviderResponse) []*tfprotov6.Diagnostic { return r.Diagnostics }))
+	return resp, err
+}
+
+func (s *providerServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	ctx, end := StartOperation(ctx, req.TypeName, OperationPlan)
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	end(operationError(err, resp, func(r *tfprotov6.PlanResourceChangeResponse) []*tfprotov6.Diagnostic { return r.Diagnostics }))
+	return resp, err
+}
+
+func (s *providerServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	ctx, end := StartOperation(ctx, req.TypeName, applyOperation(req))
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	end(operationError(err, resp, func(r *tfprotov6.ApplyResourceChangeResponse) []*tfprotov6.Diagnostic { return r.Diagnostics }))
+	return resp, err
+}
+
+func (s *providerServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	ctx, end := StartOperation(ctx, req.TypeName, OperationRead)
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	end(operationError(err, resp, func(r *tfprotov6.ReadResourceResponse) []*tfprotov6.Diagnostic { return r.Diagnostics }))
+	return resp, err
+}
+
+func (s *providerServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	ctx, end := StartOperation(ctx, req.TypeName, OperationImport)
+	resp, err := s.ProviderServer.ImportResourceState(ctx, req)
+	end(operationError(err, resp, func(r *tfprotov6.ImportResourceStateResponse) []*tfprotov6.Diagnostic { return r.Diagnostics }))
+	return resp, err
+}
+
+func (s *providerServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	ctx, end := StartOperation(ctx, req.TypeName, OperationRead)
+	resp, err := s.ProviderServer.ReadDataSource(ctx, req)
+	end(operationError(err, resp, func(r *tfprotov6.ReadDataSourceResponse) []*tfprotov6.Diagnostic { return r.Diagnostics }))
+	return resp, err
+}
+
+func (s *providerServer) MoveResourceState(ctx context.Context, req *tfprotov6.MoveResourceStateRequest) (*tfprotov6.MoveResourceStateResponse, error) {
+	server, ok := s.ProviderServer.(tfprotov6.ResourceServerWithMoveResourceState)
+	if !ok {
+		return &tfprotov6.MoveResourceStateResponse{
+			Diagnostics: []*tfprotov6.Diagnostic{{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "MoveResourceState Not Implemented",
+				Detail:   "The provider server does not support moving resource state.",
+			}},
+		}, nil
+	}
+
+	return server.MoveResourceState(ctx, req)
+}
+
+func applyOperation(req *tfprotov6.ApplyResourceChangeRequest) string {
+	switch {
+	case isNullDynamicValue(req.PriorState):
+		return OperationCreate
+	case isNullDynamicValue(req.PlannedState):
+		return OperationDelete
+	default:
+		return OperationUpdate
+	}
+}
+
+// isNullDynamicValue checks for an encoded null without needing the resource schema to decode the value.
+func isNullDynamicValue(value *tfprotov6.DynamicValue) bool {
+	if value == nil {
+		return true
+	}
+
+	if len(value.MsgPack) > 0 {
+		return len(value.MsgPack) == 1 && value.MsgPack[0] == 0xc0
+	}
+
+	return len(value.JSON) == 0 || string(value.JSON) == "null"
+}
+
+func operationError[T any](err error, resp *T, diagnostics func(*T) []*tfprotov6.Diagnostic) error {
+	if err != nil || resp == nil {
+		return err
+	}
+
+	var errs []error
+	for _, diagnostic := range diagnostics(resp) {
+		if diagnostic != nil && diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			errs = append(errs, fmt.Errorf("%s: %s", diagnostic.Summary, diagnostic.Detail))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,131 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	InstrumentationName = "github.com/OctopusDeploy/terraform-provider-octopusdeploy"
+	ServiceName         = "terraform-provider-octopusdeploy"
+
+	EnvSdkDisabled           = "OTEL_SDK_DISABLED"
+	EnvTracesExporter        = "OTEL_TRACES_EXPORTER"
+	EnvExporterEndpoint      = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	EnvTracesEndpoint        = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+	EnvExporterProtocol      = "OTEL_EXPORTER_OTLP_PROTOCOL"
+	EnvTracesProtocol        = "OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"
+	EnvTerraformTraceParent  = "TRACEPARENT"
+	protocolGrpc             = "grpc"
+	exporterOtlp             = "otlp"
+	exporterNone             = "none"
+	providerRootSpanName     = "octopusdeploy provider"
+	providerRootSpanAttrName = "terraform.provider"
+)
+
+// rootContext carries the provider process span. HTTP requests that cannot be attributed to a single
+// Terraform operation are parented here so they still appear in the trace.
+var rootContext = context.Background()
+
+// Enabled reports whether trace export has been requested through the standard OTEL_* environment variables.
+// Tracing is opt-in: an OTLP endpoint must be configured, or the otlp exporter selected explicitly.
+func Enabled() bool {
+	if strings.EqualFold(strings.TrimSpace(os.Getenv(EnvSdkDisabled)), "true") {
+		return false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(EnvTracesExporter))) {
+	case exporterNone:
+		return false
+	case exporterOtlp:
+		return true
+	case "":
+		return os.Getenv(EnvExporterEndpoint) != "" || os.Getenv(EnvTracesEndpoint) != ""
+	default:
+		// Unsupported exporters are reported by Init rather than silently ignored
+		return true
+	}
+}
+
+// Init configures the global tracer provider and W3C trace context propagation when tracing is enabled.
+// The returned function flushes and shuts down the exporter; it is always safe to call.
+func Init(ctx context.Context) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	if !Enabled() {
+		return noop, nil
+	}
+
+	if exporter := strings.ToLower(strings.TrimSpace(os.Getenv(EnvTracesExporter))); exporter != "" && exporter != exporterOtlp {
+		return noop, fmt.Errorf("unsupported %s value %q, only %q is supported", EnvTracesExporter, exporter, exporterOtlp)
+	}
+
+	exporter, err := newExporter(ctx)
+	if err != nil {
+		return noop, err
+	}
+
+	res, err := sdkresource.Merge(
+		sdkresource.NewSchemaless(semconv.ServiceName(ServiceName)),
+		sdkresource.Environment(),
+	)
+	if err != nil {
+		return noop, err
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	parent := otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier{
+		"traceparent": os.Getenv(EnvTerraformTraceParent),
+	})
+
+	var rootSpan trace.Span
+	rootContext, rootSpan = Tracer().Start(parent, providerRootSpanName, trace.WithAttributes(
+		attribute.String(providerRootSpanAttrName, "octopusdeploy"),
+	))
+
+	return func(ctx context.Context) error {
+		rootSpan.End()
+		rootContext = context.Background()
+		return errors.Join(tracerProvider.ForceFlush(ctx), tracerProvider.Shutdown(ctx))
+	}, nil
+}
+
+// Tracer returns the provider tracer from the global tracer provider. When tracing is disabled this is a no-op tracer.
+func Tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
+
+func newExporter(ctx context.Context) (*otlptrace.Exporter, error) {
+	protocol := os.Getenv(EnvTracesProtocol)
+	if protocol == "" {
+		protocol = os.Getenv(EnvExporterProtocol)
+	}
+
+	// The exporters read the remaining OTEL_EXPORTER_OTLP_* variables (endpoint, headers, timeout, TLS) themselves
+	if strings.EqualFold(strings.TrimSpace(protocol), protocolGrpc) {
+		return otlptracegrpc.New(ctx)
+	}
+
+	return otlptracehttp.New(ctx)
+}
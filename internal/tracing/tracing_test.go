@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestEnabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected bool
+	}{
+		{name: "nothing configured", env: map[string]string{}, expected: false},
+		{name: "endpoint configured", env: map[string]string{EnvExporterEndpoint: "http://localhost:4318"}, expected: true},
+		{name: "traces endpoint configured", env: map[string]string{EnvTracesEndpoint: "http://localhost:4318/v1/traces"}, expected: true},
+		{name: "otlp exporter selected", env: map[string]string{EnvTracesExporter: "otlp"}, expected: true},
+		{name: "exporter disabled", env: map[string]string{EnvTracesExporter: "none", EnvExporterEndpoint: "http://localhost:4318"}, expected: false},
+		{name: "sdk disabled", env: map[string]string{EnvSdkDisabled: "true", EnvExporterEndpoint: "http://localhost:4318"}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{EnvSdkDisabled, EnvTracesExporter, EnvExporterEndpoint, EnvTracesEndpoint} {
+				t.Setenv(key, tt.env[key])
+			}
+
+			assert.Equal(t, tt.expected, Enabled())
+		})
+	}
+}
+
+func TestInitRejectsUnsupportedExporter(t *testing.T) {
+	t.Setenv(EnvTracesExporter, "zipkin")
+
+	shutdown, err := Init(context.Background())
+	require.Error(t, err)
+	require.NoError(t, shutdown(context.Background()))
+}
+
+func TestInitExportsToCollector(t *testing.T) {
+	previousProvider := otel.GetTracerProvider()
+	previousPropagator := otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+
+	var received atomic.Int32
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path == "/v1/traces" && len(body) > 0 {
+			received.Add(1)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	t.Setenv(EnvExporterEndpoint, collector.URL)
+	t.Setenv(EnvTerraformTraceParent, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	ctx := context.Background()
+	shutdown, err := Init(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", trace.SpanContextFromContext(rootContext).TraceID().String())
+
+	_, end := StartOperation(ctx, "octopusdeploy_environment", OperationRead)
+	end(nil)
+
+	require.NoError(t, shutdown(ctx))
+	assert.Positive(t, received.Load())
+}
@@ -0,0 +1,175 @@
+package tracing
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// MaxRetriesEnvVar opts in to retrying idempotent requests that fail with a transient server response. Requests
+	// aren't retried unless it is set, whether tracing is enabled or not.
+	MaxRetriesEnvVar = "OCTOPUS_HTTP_MAX_RETRIES"
+
+	maxRetryDelay = 10 * time.Second
+)
+
+var (
+	octopusIDPattern = regexp.MustCompile(`^[A-Za-z]+(-[A-Za-z]+)*-\d+$`)
+	guidPattern      = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	numberPattern    = regexp.MustCompile(`^\d+$`)
+)
+
+// Transport records a client span for every request sent to Octopus Deploy, propagates the W3C trace context
+// and, when MaxRetries is set, retries idempotent requests that fail with a transient server response.
+type Transport struct {
+	Base       http.RoundTripper
+	MaxRetries int
+
+	// sleep is replaced in tests
+	sleep func(time.Duration)
+}
+
+var _ http.RoundTripper = (*Transport)(nil)
+
+func NewTransport(base http.RoundTripper) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &Transport{
+		Base:       base,
+		MaxRetries: maxRetriesFromEnvironment(),
+		sleep:      time.Sleep,
+	}
+}
+
+// NewHTTPClient returns the HTTP client used to talk to Octopus Deploy.
+func NewHTTPClient() *http.Client {
+	return &http.Client{Transport: NewTransport(nil)}
+}
+
+// maxRetriesFromEnvironment reads the number of retries from MaxRetriesEnvVar, which defaults to no retries.
+func maxRetriesFromEnvironment() int {
+	retries, err := strconv.Atoi(strings.TrimSpace(os.Getenv(MaxRetriesEnvVar)))
+	if err != nil || retries < 0 {
+		return 0
+	}
+	return retries
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	route := RouteTemplate(req.URL.Path)
+
+	parent, links := parentContext(req.Context())
+	ctx, span := Tracer().Start(parent, fmt.Sprintf("%s %s", req.Method, route),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithLinks(links...),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.HTTPRoute(route),
+			semconv.ServerAddress(req.URL.Hostname()),
+		),
+	)
+	defer span.End()
+
+	outgoing := req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(outgoing.Header))
+
+	retries := 0
+	for {
+		response, err := t.Base.RoundTrip(outgoing)
+
+		if retries < t.MaxRetries && isIdempotent(outgoing) && isTransient(response, err) {
+			delay := retryDelay(response, retries)
+			if response != nil {
+				io.Copy(io.Discard, response.Body)
+				response.Body.Close()
+			}
+
+			select {
+			case <-ctx.Done():
+				span.SetAttributes(semconv.HTTPRequestResendCount(retries))
+				span.RecordError(ctx.Err())
+				span.SetStatus(codes.Error, ctx.Err().Error())
+				return nil, ctx.Err()
+			default:
+			}
+
+			t.sleep(delay)
+			retries++
+			continue
+		}
+
+		span.SetAttributes(semconv.HTTPRequestResendCount(retries))
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return response, err
+		}
+
+		span.SetAttributes(semconv.HTTPResponseStatusCode(response.StatusCode))
+		if response.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, response.Status)
+		}
+
+		return response, nil
+	}
+}
+
+// RouteTemplate replaces the identifiers in an Octopus API path with placeholders, so spans for the same
+// endpoint share a name. For example /api/Spaces-1/projects/Projects-21 becomes /api/{spaceId}/projects/{id}.
+func RouteTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case strings.HasPrefix(segment, "Spaces-") && octopusIDPattern.MatchString(segment):
+			segments[i] = "{spaceId}"
+		case octopusIDPattern.MatchString(segment), guidPattern.MatchString(segment), numberPattern.MatchString(segment):
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+func isTransient(response *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func retryDelay(response *http.Response, retries int) time.Duration {
+	if response != nil {
+		if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, maxRetryDelay)
+		}
+	}
+
+	return min(time.Duration(1<<retries)*500*time.Millisecond, maxRetryDelay)
+}
@@ -0,0 +1,183 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func TestRouteTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/api":                               "/api",
+		"/api/spaces/Spaces-1":               "/api/spaces/{spaceId}",
+		"/api/Spaces-1/projects/Projects-21": "/api/{spaceId}/projects/{id}",
+		"/api/Spaces-12/machines/Machines-4/connection":                        "/api/{spaceId}/machines/{id}/connection",
+		"/api/Spaces-1/actiontemplates/ActionTemplates-1/versions/3":           "/api/{spaceId}/actiontemplates/{id}/versions/{id}",
+		"/api/Spaces-1/tenants/Tenants-1/variables":                            "/api/{spaceId}/tenants/{id}/variables",
+		"/api/users/Users-1/apikeys":                                           "/api/users/{id}/apikeys",
+		"/api/featuretoggles":                                                  "/api/featuretoggles",
+		"/api/Spaces-1/deploymentfreezes/0b4e3a9c-5f4d-4a4d-8f51-7b1c1f0f9c11": "/api/{spaceId}/deploymentfreezes/{id}",
+		"/api/Spaces-1/projects/my-project-slug":                               "/api/{spaceId}/projects/my-project-slug",
+	}
+
+	for path, expected := range tests {
+		assert.Equal(t, expected, RouteTemplate(path), path)
+	}
+}
+
+func TestTransportRecordsSpanAndPropagatesTraceContext(t *testing.T) {
+	recorder := useSpanRecorder(t)
+
+	var traceParent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceParent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx, end := StartOperation(context.Background(), "octopusdeploy_project", OperationCreate)
+	transport := NewTransport(nil)
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL+"/api/Spaces-1/projects", nil)
+	require.NoError(t, err)
+
+	response, err := transport.RoundTrip(request)
+	require.NoError(t, err)
+	response.Body.Close()
+	end(nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	httpSpan, operationSpan := spans[0], spans[1]
+	assert.Equal(t, "POST /api/{spaceId}/projects", httpSpan.Name())
+	assert.Equal(t, "octopusdeploy_project create", operationSpan.Name())
+	assert.Equal(t, operationSpan.SpanContext().SpanID(), httpSpan.Parent().SpanID())
+	assert.Equal(t, operationSpan.SpanContext().TraceID(), oteltrace.SpanContextFromContext(ctx).TraceID())
+
+	attributes := attributeMap(httpSpan.Attributes())
+	assert.Equal(t, "POST", attributes["http.request.method"].AsString())
+	assert.Equal(t, "/api/{spaceId}/projects", attributes["http.route"].AsString())
+	assert.Equal(t, int64(200), attributes["http.response.status_code"].AsInt64())
+	assert.Equal(t, int64(0), attributes["http.request.resend_count"].AsInt64())
+
+	assert.Contains(t, traceParent, httpSpan.SpanContext().SpanID().String())
+	assert.Contains(t, traceParent, httpSpan.SpanContext().TraceID().String())
+}
+
+func TestTransportRetriesTransientResponsesForIdempotentRequests(t *testing.T) {
+	recorder := useSpanRecorder(t)
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Setenv(MaxRetriesEnvVar, "2")
+	transport := NewTransport(nil)
+	transport.sleep = func(time.Duration) {}
+
+	request, err := http.NewRequest(http.MethodGet, server.URL+"/api/Spaces-1/environments/Environments-2", nil)
+	require.NoError(t, err)
+
+	response, err := transport.RoundTrip(request)
+	require.NoError(t, err)
+	response.Body.Close()
+
+	assert.Equal(t, int32(2), attempts.Load())
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	attributes := attributeMap(spans[0].Attributes())
+	assert.Equal(t, int64(1), attributes["http.request.resend_count"].AsInt64())
+	assert.Equal(t, int64(200), attributes["http.response.status_code"].AsInt64())
+}
+
+func TestTransportDoesNotRetryNonIdempotentRequests(t *testing.T) {
+	useSpanRecorder(t)
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	t.Setenv(MaxRetriesEnvVar, "2")
+	transport := NewTransport(nil)
+	transport.sleep = func(time.Duration) {}
+
+	request, err := http.NewRequest(http.MethodPut, server.URL+"/api/Spaces-1/environments/Environments-2", nil)
+	require.NoError(t, err)
+
+	response, err := transport.RoundTrip(request)
+	require.NoError(t, err)
+	response.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestTransportDoesNotRetryUnlessRequested(t *testing.T) {
+	useSpanRecorder(t)
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	t.Setenv(MaxRetriesEnvVar, "")
+	transport := NewTransport(nil)
+	transport.sleep = func(time.Duration) { t.Fatal("the request was retried") }
+
+	request, err := http.NewRequest(http.MethodGet, server.URL+"/api/Spaces-1/environments/Environments-2", nil)
+	require.NoError(t, err)
+
+	response, err := transport.RoundTrip(request)
+	require.NoError(t, err)
+	response.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func useSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previousProvider := otel.GetTracerProvider()
+	previousPropagator := otel.GetTextMapPropagator()
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+
+	return recorder
+}
+
+func attributeMap(attributes []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	result := make(map[attribute.Key]attribute.Value, len(attributes))
+	for _, kv := range attributes {
+		result[kv.Key] = kv.Value
+	}
+	return result
+}
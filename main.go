@@ -5,6 +5,8 @@ import (
 	"flag"
 	"log"
 
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/offline"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/tracing"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -23,6 +25,12 @@ func main() {
 
 	ctx := context.Background()
 
+	shutdownTracing, err := tracing.Init(ctx)
+	if err != nil {
+		log.Printf("[WARN] OpenTelemetry tracing is disabled: %s", err.Error())
+	}
+	defer shutdownTracing(ctx)
+
 	upgradedSdkServer, err := tf5to6server.UpgradeServer(
 		ctx,
 		octopusdeploy.Provider().GRPCProvider)
@@ -47,8 +55,11 @@ func main() {
 		providerName = "octopus.com/com/octopusdeploy"
 	}
 
-	err = tf6server.Serve(providerName, muxServer.ProviderServer, opts...)
+	err = tf6server.Serve(providerName, func() tfprotov6.ProviderServer {
+		return tracing.NewProviderServer(offline.NewProviderServer(muxServer.ProviderServer()))
+	}, opts...)
 	if err != nil {
+		shutdownTracing(ctx)
 		log.Fatal(err)
 	}
 }
@@ -1,20 +1,26 @@
 package octopusdeploy
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/spaces"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/credentials"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/tracing"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 // Config holds Address and the APIKey of the Octopus Deploy server
 type Config struct {
-	Address     string
-	APIKey      string
-	AccessToken string
-	SpaceID     string
+	Address           string
+	APIKey            string
+	AccessToken       string
+	CredentialCommand []string
+	SpaceID           string
+	SpaceName         string
 }
 
 // Client returns a new Octopus Deploy client
@@ -24,6 +30,18 @@ func (c *Config) Client() (*client.Client, diag.Diagnostics) {
 		return nil, diag.FromErr(err)
 	}
 
+	if len(c.SpaceID) > 0 && len(c.SpaceName) > 0 {
+		return nil, diag.Errorf("only one of space_id or space_name can be specified")
+	}
+
+	if len(c.SpaceName) > 0 {
+		space, err := getSpaceByName(octopus, c.SpaceName)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		c.SpaceID = space.GetID()
+	}
+
 	if len(c.SpaceID) > 0 {
 		space, err := spaces.GetByID(octopus, c.SpaceID)
 		if err != nil {
@@ -54,7 +72,44 @@ func getClientForSpace(c *Config, spaceID string) (*client.Client, error) {
 		return nil, err
 	}
 
-	return client.NewClientWithCredentials(nil, apiURL, credential, spaceID, "TerraformProvider")
+	httpClient := tracing.NewHTTPClient()
+	if commandCredential, ok := credential.(*credentials.CommandCredential); ok {
+		httpClient.Transport = commandCredential.Transport(httpClient.Transport)
+	}
+
+	return client.NewClientWithCredentials(httpClient, apiURL, credential, spaceID, "TerraformProvider")
+}
+
+func getSpaceByName(octopus *client.Client, name string) (*spaces.Space, error) {
+	allSpaces, err := spaces.GetAll(octopus)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, space := range allSpaces {
+		if strings.EqualFold(space.Name, name) {
+			return space, nil
+		}
+	}
+
+	return nil, fmt.Errorf("the space '%s' cannot be found", name)
+}
+
+// applyCliProfile fills in any connection settings that were not set on the provider or through environment variables.
+func (c *Config) applyCliProfile(profile *credentials.CliProfile) {
+	if c.Address == "" {
+		c.Address = profile.Url
+	}
+
+	if c.APIKey == "" && c.AccessToken == "" && len(c.CredentialCommand) == 0 {
+		c.APIKey = profile.ApiKey
+		c.AccessToken = profile.AccessToken
+	}
+
+	if c.SpaceID == "" && c.SpaceName == "" {
+		c.SpaceID = profile.SpaceID()
+		c.SpaceName = profile.SpaceName()
+	}
 }
 
 func getApiCredential(c *Config) (client.ICredential, error) {
@@ -76,5 +131,14 @@ func getApiCredential(c *Config) (client.ICredential, error) {
 		return credential, nil
 	}
 
-	return nil, fmt.Errorf("either an APIKey or an AccessToken is required to connect to the Octopus Server instance")
+	if len(c.CredentialCommand) > 0 {
+		credential := credentials.NewCommandCredential(c.CredentialCommand)
+		if _, err := credential.Refresh(context.Background()); err != nil {
+			return nil, err
+		}
+
+		return credential, nil
+	}
+
+	return nil, fmt.Errorf("either an APIKey, an AccessToken or a credential command is required to connect to the Octopus Server instance")
 }
@@ -0,0 +1,43 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/health"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// waitForDeploymentTargetHealthy waits for the deployment target to become healthy when the wait_for_healthy block
+// is set. It returns true when it waited, so the caller can refresh the health status.
+func waitForDeploymentTargetHealthy(ctx context.Context, d *schema.ResourceData, octopus *client.Client, deploymentTarget *machines.DeploymentTarget) (bool, diag.Diagnostics) {
+	options, err := health.ExpandWaitForHealthy(d.Get("wait_for_healthy"))
+	if err != nil {
+		return false, diag.FromErr(err)
+	}
+	if options == nil {
+		return false, nil
+	}
+
+	spaceID := deploymentTarget.SpaceID
+	check := health.NewMachineCheck(octopus, spaceID, deploymentTarget.GetID(), deploymentTarget.Name, func() (health.MachineStatus, error) {
+		target, err := machines.GetByID(octopus, spaceID, deploymentTarget.GetID())
+		if err != nil {
+			return health.MachineStatus{}, err
+		}
+		return health.MachineStatus{HealthStatus: target.HealthStatus, StatusSummary: target.StatusSummary}, nil
+	})
+
+	if err := health.WaitForHealthy(ctx, check, *options); err != nil {
+		return true, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("deployment target '%s' did not become healthy", deploymentTarget.Name),
+			Detail:   err.Error(),
+		}}
+	}
+
+	return true, nil
+}
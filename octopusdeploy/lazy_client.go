@@ -0,0 +1,91 @@
+package octopusdeploy
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// lazyClient is the provider meta. It connects to the Octopus Server the first time a resource or data source
+// needs the client, so the provider can be configured without a reachable server.
+type lazyClient struct {
+	config *Config
+
+	once   sync.Once
+	client *client.Client
+	diags  diag.Diagnostics
+}
+
+func newLazyClient(config *Config) *lazyClient {
+	return &lazyClient{config: config}
+}
+
+// Client returns the connected client. A nil config means the provider configuration is not known until apply.
+func (l *lazyClient) Client() (*client.Client, diag.Diagnostics) {
+	l.once.Do(func() {
+		if l.config == nil {
+			l.diags = diag.Errorf("the provider configuration is not known until apply, so there is no connection to an Octopus Server")
+			return
+		}
+
+		l.client, l.diags = l.config.Client()
+	})
+
+	return l.client, l.diags
+}
+
+// withLazyClient passes the connected client to the CRUD functions of the resources and data sources, which
+// expect the meta to be a *client.Client.
+func withLazyClient(resources map[string]*schema.Resource) {
+	for _, resource := range resources {
+		resource.CreateContext = withClientContext(resource.CreateContext)
+		resource.ReadContext = withClientContext(resource.ReadContext)
+		resource.UpdateContext = withClientContext(resource.UpdateContext)
+		resource.DeleteContext = withClientContext(resource.DeleteContext)
+
+		if read := resource.Read; read != nil {
+			resource.Read = func(d *schema.ResourceData, m interface{}) error {
+				octopus, diags := connectedClient(m)
+				if diags.HasError() {
+					return diagnosticsError(diags)
+				}
+				return read(d, octopus)
+			}
+		}
+	}
+}
+
+func withClientContext[T ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](f T) T {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		octopus, diags := connectedClient(m)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, f(ctx, d, octopus)...)
+	}
+}
+
+func connectedClient(m interface{}) (interface{}, diag.Diagnostics) {
+	if lazy, ok := m.(*lazyClient); ok {
+		return lazy.Client()
+	}
+	return m, nil
+}
+
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			errs = append(errs, errors.New(d.Summary))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,46 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLazyClientWithUnknownConfigurationReturnsError(t *testing.T) {
+	var called bool
+	resources := map[string]*schema.Resource{
+		"octopusdeploy_test": {
+			ReadContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+				called = true
+				return nil
+			},
+		},
+	}
+
+	withLazyClient(resources)
+	diags := resources["octopusdeploy_test"].ReadContext(context.Background(), nil, newLazyClient(nil))
+
+	require.True(t, diags.HasError())
+	require.False(t, called)
+}
+
+func TestLazyClientPassesOtherMetaThrough(t *testing.T) {
+	var received interface{}
+	resources := map[string]*schema.Resource{
+		"octopusdeploy_test": {
+			ReadContext: func(_ context.Context, _ *schema.ResourceData, m interface{}) diag.Diagnostics {
+				received = m
+				return nil
+			},
+		},
+	}
+
+	withLazyClient(resources)
+	diags := resources["octopusdeploy_test"].ReadContext(context.Background(), nil, "meta")
+
+	require.False(t, diags.HasError())
+	require.Equal(t, "meta", received)
+}
@@ -2,13 +2,15 @@ package octopusdeploy
 
 import (
 	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/credentials"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // Provider is the plugin entry point for the Terraform provider for Octopus Deploy.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		DataSourcesMap: map[string]*schema.Resource{
 			"octopusdeploy_accounts":                                        dataSourceAccounts(),
 			"octopusdeploy_azure_cloud_service_deployment_targets":          dataSourceAzureCloudServiceDeploymentTargets(),
@@ -77,18 +79,53 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Type:        schema.TypeString,
 			},
+			"credential_command": {
+				Description: "A command and its arguments that prints a JSON document with an `access_token` or `api_key` and an optional `expires_at` (RFC 3339) or `expires_in` (seconds). The command is run again when the credential expires. Used when neither an API key nor an access token is configured",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Type:        schema.TypeList,
+			},
+			"profile": {
+				DefaultFunc: schema.EnvDefaultFunc(credentials.ProfileEnvVar, nil),
+				Description: "The name of an Octopus CLI profile to read the server URL, credentials and space from. The `default` profile is the configuration written by `octopus login`; other names are read from the provider-specific `profiles` object of the CLI configuration file. Settings configured on the provider or through environment variables take precedence. Can also be set with the OCTOPUS_PROFILE environment variable",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
 			"space_id": {
 				Description: "The space ID to target",
 				Optional:    true,
 				Type:        schema.TypeString,
 			},
+			"space_name": {
+				Description: "The name of the space to target, as an alternative to space_id",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
 		},
 
-		ConfigureContextFunc: providerConfigure,
+		ConfigureProvider: providerConfigure,
 	}
+
+	withLazyClient(provider.DataSourcesMap)
+	withLazyClient(provider.ResourcesMap)
+
+	return provider
 }
 
-func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+func providerConfigure(ctx context.Context, req schema.ConfigureProviderRequest, resp *schema.ConfigureProviderResponse) {
+	d := req.ResourceData
+
+	// The address or credentials depend on values that are not known until apply
+	if !d.GetRawConfig().IsWhollyKnown() {
+		if req.DeferralAllowed {
+			resp.Deferred = &schema.Deferred{Reason: schema.DeferredReasonProviderConfigUnknown}
+			return
+		}
+
+		resp.Meta = newLazyClient(nil)
+		return
+	}
+
 	config := Config{
 		AccessToken: d.Get("access_token").(string),
 		Address:     d.Get("address").(string),
@@ -97,6 +134,20 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	if spaceID, ok := d.GetOk("space_id"); ok {
 		config.SpaceID = spaceID.(string)
 	}
+	if spaceName, ok := d.GetOk("space_name"); ok {
+		config.SpaceName = spaceName.(string)
+	}
+	if command, ok := d.GetOk("credential_command"); ok {
+		config.CredentialCommand = expandArray(command.([]interface{}))
+	}
+	if profile, ok := d.GetOk("profile"); ok {
+		cliProfile, err := credentials.LoadCliProfile(profile.(string))
+		if err != nil {
+			resp.Diagnostics = diag.FromErr(err)
+			return
+		}
+		config.applyCliProfile(cliProfile)
+	}
 
-	return config.Client()
+	resp.Meta = newLazyClient(&config)
 }
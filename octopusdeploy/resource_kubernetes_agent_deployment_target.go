@@ -30,6 +30,10 @@ func resourceKubernetesAgentDeploymentTargetCreate(ctx context.Context, d *schem
 	}
 
 	d.SetId(createdDeploymentTarget.GetID())
+
+	if waited, diags := waitForDeploymentTargetHealthy(ctx, d, client, createdDeploymentTarget); waited {
+		return append(diags, resourceKubernetesAgentDeploymentTargetRead(ctx, d, m)...)
+	}
 	return nil
 }
 
@@ -75,5 +79,11 @@ func resourceKubernetesAgentDeploymentTargetUpdate(ctx context.Context, d *schem
 
 	d.SetId(updatedDeploymentTarget.GetID())
 
+	if d.HasChanges("uri", "thumbprint", "wait_for_healthy") {
+		if waited, diags := waitForDeploymentTargetHealthy(ctx, d, client, updatedDeploymentTarget); waited {
+			return append(diags, resourceKubernetesAgentDeploymentTargetRead(ctx, d, m)...)
+		}
+	}
+
 	return nil
 }
@@ -41,6 +41,10 @@ func resourceListeningTentacleDeploymentTargetCreate(ctx context.Context, d *sch
 	d.SetId(createdDeploymentTarget.GetID())
 
 	log.Printf("[INFO] listening tentacle deployment target created (%s)", d.Id())
+
+	if waited, diags := waitForDeploymentTargetHealthy(ctx, d, client, createdDeploymentTarget); waited {
+		return append(diags, resourceListeningTentacleDeploymentTargetRead(ctx, d, m)...)
+	}
 	return nil
 }
 
@@ -90,5 +94,11 @@ func resourceListeningTentacleDeploymentTargetUpdate(ctx context.Context, d *sch
 	}
 
 	log.Printf("[INFO] listening tentacle deployment target updated (%s)", d.Id())
+
+	if d.HasChanges("tentacle_url", "thumbprint", "proxy_id", "wait_for_healthy") {
+		if waited, diags := waitForDeploymentTargetHealthy(ctx, d, client, updatedDeploymentTarget); waited {
+			return append(diags, resourceListeningTentacleDeploymentTargetRead(ctx, d, m)...)
+		}
+	}
 	return nil
 }
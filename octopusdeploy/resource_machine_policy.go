@@ -3,6 +3,7 @@ package octopusdeploy
 import (
 	"context"
 	"log"
+	"reflect"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machinepolicies"
@@ -14,6 +15,7 @@ import (
 func resourceMachinePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceMachinePolicyCreate,
+		CustomizeDiff: resourceMachinePolicyCustomizeDiff,
 		DeleteContext: resourceMachinePolicyDelete,
 		Description:   "This resource manages machine policies in Octopus Deploy.",
 		Importer:      getImporter(),
@@ -24,7 +26,10 @@ func resourceMachinePolicy() *schema.Resource {
 }
 
 func resourceMachinePolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	machinePolicy := expandMachinePolicy(d)
+	machinePolicy, err := expandMachinePolicy(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	log.Printf("[INFO] creating machine policy: %#v", machinePolicy)
 
@@ -44,6 +49,29 @@ func resourceMachinePolicyCreate(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// resourceMachinePolicyCustomizeDiff plans the hashes of the health check scripts, so that a change of a script file is
+// planned as a change of the machine policy.
+func resourceMachinePolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("machine_health_check_policy") {
+		return d.SetNewComputed("health_check_script_sha256")
+	}
+
+	v, ok := d.GetOk("machine_health_check_policy")
+	if !ok {
+		return nil // The health check policy of Octopus Deploy is used
+	}
+
+	hashes, err := expandMachineHealthCheckScriptSha256(v)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(hashes, d.Get("health_check_script_sha256")) {
+		return d.SetNew("health_check_script_sha256", hashes)
+	}
+	return nil
+}
+
 func resourceMachinePolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting machine policy (%s)", d.Id())
 
@@ -80,7 +108,11 @@ func resourceMachinePolicyRead(ctx context.Context, d *schema.ResourceData, m in
 func resourceMachinePolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] updating machine policy (%s)", d.Id())
 
-	machinePolicy := expandMachinePolicy(d)
+	machinePolicy, err := expandMachinePolicy(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	client := m.(*client.Client)
 	updatedMachinePolicy, err := machinepolicies.Update(client, machinePolicy)
 	if err != nil {
@@ -3,6 +3,7 @@ package octopusdeploy
 import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/health"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/url"
@@ -58,6 +59,8 @@ func flattenKubernetesAgentDeploymentTarget(deploymentTarget *machines.Deploymen
 	flattenedDeploymentTarget["tenanted_deployment_participation"] = deploymentTarget.TenantedDeploymentMode
 	flattenedDeploymentTarget["tenants"] = deploymentTarget.TenantIDs
 	flattenedDeploymentTarget["tenant_tags"] = deploymentTarget.TenantTags
+	flattenedDeploymentTarget["health_status"] = deploymentTarget.HealthStatus
+	flattenedDeploymentTarget["status_summary"] = deploymentTarget.StatusSummary
 
 	flattenedDeploymentTarget["thumbprint"] = endpoint.TentacleEndpointConfiguration.Thumbprint
 	flattenedDeploymentTarget["uri"] = endpoint.TentacleEndpointConfiguration.URI.String()
@@ -84,7 +87,9 @@ func getKubernetesAgentDeploymentTargetSchemaForResource() map[string]*schema.Sc
 		return strings.EqualFold(old, new)
 	}
 
-	return getKubernetesAgentDeploymentTargetSchema(uriDiffSuppress)
+	resourceSchema := getKubernetesAgentDeploymentTargetSchema(uriDiffSuppress)
+	resourceSchema["wait_for_healthy"] = health.GetWaitForHealthySchema()
+	return resourceSchema
 }
 
 func getKubernetesAgentDeploymentTargetSchema(uriDiffSuppress schema.SchemaDiffSuppressFunc) map[string]*schema.Schema {
@@ -178,6 +183,16 @@ func getKubernetesAgentDeploymentTargetSchema(uriDiffSuppress schema.SchemaDiffS
 			Computed:    true,
 			Type:        schema.TypeString,
 		},
+		"health_status": {
+			Description: "The health status of the agent. One of 'HasWarnings', 'Healthy', 'Unavailable', 'Unhealthy', 'Unknown'",
+			Computed:    true,
+			Type:        schema.TypeString,
+		},
+		"status_summary": {
+			Description: "A summary elaborating on the health status of the agent.",
+			Computed:    true,
+			Type:        schema.TypeString,
+		},
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"net/url"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/health"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -49,6 +50,7 @@ func flattenListeningTentacleDeploymentTarget(deploymentTarget *machines.Deploym
 
 func getListeningTentacleDeploymentTargetDataSchema() map[string]*schema.Schema {
 	dataSchema := getListeningTentacleDeploymentTargetSchema()
+	delete(dataSchema, "wait_for_healthy")
 	setDataSchema(&dataSchema)
 
 	deploymentTargetDataSchema := getDeploymentTargetDataSchema()
@@ -169,6 +171,7 @@ func getListeningTentacleDeploymentTargetSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			// ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
 		},
+		"wait_for_healthy": health.GetWaitForHealthySchema(),
 	}
 }
 
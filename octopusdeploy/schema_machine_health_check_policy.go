@@ -1,20 +1,22 @@
 package octopusdeploy
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machinepolicies"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func expandMachineHealthCheckPolicy(values interface{}) *machinepolicies.MachineHealthCheckPolicy {
+func expandMachineHealthCheckPolicy(values interface{}) (*machinepolicies.MachineHealthCheckPolicy, error) {
 	if values == nil {
-		return nil
+		return nil, nil
 	}
 	flattenedValues := values.(*schema.Set)
 	if len(flattenedValues.List()) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	flattenedMap := flattenedValues.List()[0].(map[string]interface{})
@@ -23,7 +25,11 @@ func expandMachineHealthCheckPolicy(values interface{}) *machinepolicies.Machine
 
 	if v, ok := flattenedMap["bash_health_check_policy"]; ok {
 		if len(v.([]interface{})) > 0 {
-			machineHealthCheckPolicy.BashHealthCheckPolicy = expandMachineScriptPolicy(v)
+			scriptPolicy, err := expandMachineScriptPolicy(v)
+			if err != nil {
+				return nil, fmt.Errorf("error reading bash_health_check_policy: %w", err)
+			}
+			machineHealthCheckPolicy.BashHealthCheckPolicy = scriptPolicy
 		}
 	}
 
@@ -47,11 +53,15 @@ func expandMachineHealthCheckPolicy(values interface{}) *machinepolicies.Machine
 
 	if v, ok := flattenedMap["powershell_health_check_policy"]; ok {
 		if len(v.([]interface{})) > 0 {
-			machineHealthCheckPolicy.PowerShellHealthCheckPolicy = expandMachineScriptPolicy(v)
+			scriptPolicy, err := expandMachineScriptPolicy(v)
+			if err != nil {
+				return nil, fmt.Errorf("error reading powershell_health_check_policy: %w", err)
+			}
+			machineHealthCheckPolicy.PowerShellHealthCheckPolicy = scriptPolicy
 		}
 	}
 
-	return machineHealthCheckPolicy
+	return machineHealthCheckPolicy, nil
 }
 
 func flattenMachineHealthCheckPolicy(machineHealthCheckPolicy *machinepolicies.MachineHealthCheckPolicy) []interface{} {
@@ -109,3 +119,89 @@ func getMachineHealthCheckPolicySchema() map[string]*schema.Schema {
 		},
 	}
 }
+
+// machineHealthCheckScriptPolicies are the keys of the health check scripts in health_check_script_sha256 by the name
+// of their script policy.
+var machineHealthCheckScriptPolicies = map[string]string{
+	"bash_health_check_policy":       "bash",
+	"powershell_health_check_policy": "powershell",
+}
+
+// flattenMachineHealthCheckScriptSha256 returns the hashes of the health check scripts of a machine health check policy.
+func flattenMachineHealthCheckScriptSha256(machineHealthCheckPolicy *machinepolicies.MachineHealthCheckPolicy) map[string]interface{} {
+	hashes := map[string]interface{}{}
+	if machineHealthCheckPolicy == nil {
+		return hashes
+	}
+
+	scriptPolicies := map[string]*machinepolicies.MachineScriptPolicy{
+		"bash":       machineHealthCheckPolicy.BashHealthCheckPolicy,
+		"powershell": machineHealthCheckPolicy.PowerShellHealthCheckPolicy,
+	}
+	for name, scriptPolicy := range scriptPolicies {
+		if scriptPolicy != nil && scriptPolicy.ScriptBody != nil {
+			hashes[name] = util.ScriptSha256(*scriptPolicy.ScriptBody)
+		}
+	}
+	return hashes
+}
+
+// expandMachineHealthCheckScriptSha256 returns the hashes of the configured health check scripts of a machine health
+// check policy, reading the scripts of script files.
+func expandMachineHealthCheckScriptSha256(values interface{}) (map[string]interface{}, error) {
+	hashes := map[string]interface{}{}
+	flattenedValues, ok := values.(*schema.Set)
+	if !ok || flattenedValues.Len() == 0 {
+		return hashes, nil
+	}
+
+	flattenedMap := flattenedValues.List()[0].(map[string]interface{})
+	for key, name := range machineHealthCheckScriptPolicies {
+		scriptPolicies, _ := flattenedMap[key].([]interface{})
+		if len(scriptPolicies) == 0 || scriptPolicies[0] == nil {
+			continue
+		}
+
+		scriptBody, err := machineScriptPolicyBody(scriptPolicies[0].(map[string]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %w", key, err)
+		}
+		hashes[name] = util.ScriptSha256(scriptBody)
+	}
+	return hashes, nil
+}
+
+// keepMachineHealthCheckScriptConfiguration keeps the configured form of the health check scripts in a flattened
+// machine health check policy: scripts read from a script file keep the file instead of the script, and scripts that
+// only differ from the configured script in their line endings keep the configured script.
+func keepMachineHealthCheckScriptConfiguration(flattened []interface{}, configured interface{}) {
+	configuredValues, ok := configured.(*schema.Set)
+	if !ok || configuredValues.Len() == 0 || len(flattened) == 0 {
+		return
+	}
+
+	configuredMap := configuredValues.List()[0].(map[string]interface{})
+	flattenedMap := flattened[0].(map[string]interface{})
+	for key := range machineHealthCheckScriptPolicies {
+		scriptPolicies, _ := flattenedMap[key].([]interface{})
+		configuredScriptPolicies, _ := configuredMap[key].([]interface{})
+		if len(scriptPolicies) == 0 || len(configuredScriptPolicies) == 0 || configuredScriptPolicies[0] == nil {
+			continue
+		}
+
+		scriptPolicy := scriptPolicies[0].(map[string]interface{})
+		configuredScriptPolicy := configuredScriptPolicies[0].(map[string]interface{})
+
+		scriptBody := ""
+		if v, ok := scriptPolicy["script_body"].(*string); ok && v != nil {
+			scriptBody = *v
+		}
+
+		if scriptFile, _ := configuredScriptPolicy["script_file"].(string); scriptFile != "" {
+			scriptPolicy["script_file"] = scriptFile
+			scriptPolicy["script_body"] = ""
+		} else if configuredBody, _ := configuredScriptPolicy["script_body"].(string); util.NormalizeScript(configuredBody) == util.NormalizeScript(scriptBody) {
+			scriptPolicy["script_body"] = configuredBody
+		}
+	}
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func expandMachinePolicy(d *schema.ResourceData) *machinepolicies.MachinePolicy {
+func expandMachinePolicy(d *schema.ResourceData) (*machinepolicies.MachinePolicy, error) {
 	name := d.Get("name").(string)
 
 	machinePolicy := machinepolicies.NewMachinePolicy(name)
@@ -54,7 +54,11 @@ func expandMachinePolicy(d *schema.ResourceData) *machinepolicies.MachinePolicy
 
 	if v, ok := d.GetOk("machine_health_check_policy"); ok {
 		if len(v.(*schema.Set).List()) > 0 {
-			machinePolicy.MachineHealthCheckPolicy = expandMachineHealthCheckPolicy(v)
+			machineHealthCheckPolicy, err := expandMachineHealthCheckPolicy(v)
+			if err != nil {
+				return nil, fmt.Errorf("error reading machine_health_check_policy: %w", err)
+			}
+			machinePolicy.MachineHealthCheckPolicy = machineHealthCheckPolicy
 		}
 	}
 
@@ -82,8 +86,7 @@ func expandMachinePolicy(d *schema.ResourceData) *machinepolicies.MachinePolicy
 		machinePolicy.SpaceID = v.(string)
 	}
 
-	return machinePolicy
-
+	return machinePolicy, nil
 }
 
 func flattenMachinePolicy(machinePolicy *machinepolicies.MachinePolicy) map[string]interface{} {
@@ -102,6 +105,7 @@ func flattenMachinePolicy(machinePolicy *machinepolicies.MachinePolicy) map[stri
 		"machine_cleanup_policy":                 flattenMachineCleanupPolicy(machinePolicy.MachineCleanupPolicy),
 		"machine_connectivity_policy":            flattenMachineConnectivityPolicy(machinePolicy.MachineConnectivityPolicy),
 		"machine_health_check_policy":            flattenMachineHealthCheckPolicy(machinePolicy.MachineHealthCheckPolicy),
+		"health_check_script_sha256":             flattenMachineHealthCheckScriptSha256(machinePolicy.MachineHealthCheckPolicy),
 		"machine_update_policy":                  flattenMachineUpdatePolicy(machinePolicy.MachineUpdatePolicy),
 		"machine_package_cache_retention_policy": flattenMachinePackageCacheRetentionPolicy(machinePolicy.MachinePackageCacheRetentionPolicy),
 		"name":                                   machinePolicy.Name,
@@ -182,6 +186,12 @@ func getMachinePolicySchema() map[string]*schema.Schema {
 			Optional: true,
 			Type:     schema.TypeSet,
 		},
+		"health_check_script_sha256": {
+			Computed:    true,
+			Description: "The SHA-256 hashes of the health check scripts, with line endings normalised to LF, by script type (`bash` or `powershell`). An edit of a `script_file` is planned as a change of its hash; unlike `octopusdeploy_process_step`, the plan does not show the changed lines of the script.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeMap,
+		},
 		"machine_update_policy": {
 			Computed: true,
 			Elem:     &schema.Resource{Schema: getMachineUpdatePolicySchema()},
@@ -227,10 +237,16 @@ func setMachinePolicy(ctx context.Context, d *schema.ResourceData, machinePolicy
 		return fmt.Errorf("error setting machine_connectivity_policy: %s", err)
 	}
 
-	if err := d.Set("machine_health_check_policy", flattenMachineHealthCheckPolicy(machinePolicy.MachineHealthCheckPolicy)); err != nil {
+	machineHealthCheckPolicy := flattenMachineHealthCheckPolicy(machinePolicy.MachineHealthCheckPolicy)
+	keepMachineHealthCheckScriptConfiguration(machineHealthCheckPolicy, d.Get("machine_health_check_policy"))
+	if err := d.Set("machine_health_check_policy", machineHealthCheckPolicy); err != nil {
 		return fmt.Errorf("error setting machine_health_check_policy: %s", err)
 	}
 
+	if err := d.Set("health_check_script_sha256", flattenMachineHealthCheckScriptSha256(machinePolicy.MachineHealthCheckPolicy)); err != nil {
+		return fmt.Errorf("error setting health_check_script_sha256: %s", err)
+	}
+
 	if err := d.Set("machine_update_policy", flattenMachineUpdatePolicy(machinePolicy.MachineUpdatePolicy)); err != nil {
 		return fmt.Errorf("error setting machine_update_policy: %s", err)
 	}
@@ -1,18 +1,21 @@
 package octopusdeploy
 
 import (
+	"fmt"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machinepolicies"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func expandMachineScriptPolicy(values interface{}) *machinepolicies.MachineScriptPolicy {
+func expandMachineScriptPolicy(values interface{}) (*machinepolicies.MachineScriptPolicy, error) {
 	if values == nil {
-		return nil
+		return nil, nil
 	}
 	flattenedValues := values.([]interface{})
 	if len(flattenedValues) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	flattenedMap := flattenedValues[0].(map[string]interface{})
@@ -23,12 +26,30 @@ func expandMachineScriptPolicy(values interface{}) *machinepolicies.MachineScrip
 		machineScriptPolicy.RunType = v.(string)
 	}
 
-	if v, ok := flattenedMap["script_body"]; ok {
-		scriptBody := v.(string)
+	if _, ok := flattenedMap["script_body"]; ok {
+		scriptBody, err := machineScriptPolicyBody(flattenedMap)
+		if err != nil {
+			return nil, err
+		}
 		machineScriptPolicy.ScriptBody = &scriptBody
 	}
 
-	return machineScriptPolicy
+	return machineScriptPolicy, nil
+}
+
+// machineScriptPolicyBody returns the script of a health check script policy, which is read from the script file when
+// one is set.
+func machineScriptPolicyBody(flattenedMap map[string]interface{}) (string, error) {
+	scriptBody, _ := flattenedMap["script_body"].(string)
+	scriptFile, _ := flattenedMap["script_file"].(string)
+	if scriptFile == "" {
+		return scriptBody, nil
+	}
+
+	if scriptBody != "" {
+		return "", fmt.Errorf("only one of script_body or script_file can be set for a health check script")
+	}
+	return util.ReadScriptFile(scriptFile)
 }
 
 func flattenMachineScriptPolicy(machineScriptPolicy *machinepolicies.MachineScriptPolicy) []interface{} {
@@ -58,5 +79,10 @@ func getMachineScriptPolicySchema() map[string]*schema.Schema {
 			Optional: true,
 			Type:     schema.TypeString,
 		},
+		"script_file": {
+			Description: "Path of a file holding the health check script, relative to the working directory of Terraform. The script is sent with line endings normalised to LF. Conflicts with `script_body`.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
 	}
 }
@@ -0,0 +1,58 @@
+package octopusdeploy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandMachineScriptPolicyFromScriptFile(t *testing.T) {
+	scriptFile := filepath.Join(t.TempDir(), "health-check.sh")
+	require.NoError(t, os.WriteFile(scriptFile, []byte("echo healthy\r\n"), 0o600))
+
+	actual, err := expandMachineScriptPolicy([]interface{}{map[string]interface{}{
+		"run_type":    "Inline",
+		"script_body": "",
+		"script_file": scriptFile,
+	}})
+	require.NoError(t, err)
+	require.Equal(t, "echo healthy\n", *actual.ScriptBody)
+
+	_, err = expandMachineScriptPolicy([]interface{}{map[string]interface{}{
+		"run_type":    "Inline",
+		"script_body": "echo healthy",
+		"script_file": scriptFile,
+	}})
+	require.ErrorContains(t, err, "only one of script_body or script_file can be set")
+}
+
+func TestKeepMachineHealthCheckScriptConfiguration(t *testing.T) {
+	serverScript := "echo healthy\n"
+	flattened := []interface{}{map[string]interface{}{
+		"bash_health_check_policy":       []interface{}{map[string]interface{}{"run_type": "Inline", "script_body": &serverScript}},
+		"powershell_health_check_policy": []interface{}{map[string]interface{}{"run_type": "Inline", "script_body": &serverScript}},
+	}}
+	configured := schema.NewSet(func(interface{}) int { return 0 }, []interface{}{map[string]interface{}{
+		"bash_health_check_policy":       []interface{}{map[string]interface{}{"run_type": "Inline", "script_body": "", "script_file": "health-check.sh"}},
+		"powershell_health_check_policy": []interface{}{map[string]interface{}{"run_type": "Inline", "script_body": "echo healthy\r\n", "script_file": ""}},
+	}})
+
+	keepMachineHealthCheckScriptConfiguration(flattened, configured)
+
+	policy := flattened[0].(map[string]interface{})
+	bash := policy["bash_health_check_policy"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "health-check.sh", bash["script_file"])
+	require.Equal(t, "", bash["script_body"])
+	powershell := policy["powershell_health_check_policy"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "echo healthy\r\n", powershell["script_body"])
+
+	hashes, err := expandMachineHealthCheckScriptSha256(schema.NewSet(func(interface{}) int { return 0 }, []interface{}{map[string]interface{}{
+		"powershell_health_check_policy": []interface{}{map[string]interface{}{"script_body": "echo healthy\r\n"}},
+	}}))
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"powershell": util.ScriptSha256(serverScript)}, hashes)
+}
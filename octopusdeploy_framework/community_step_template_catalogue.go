@@ -0,0 +1,207 @@
+package octopusdeploy_framework
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actions"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/tracing"
+)
+
+// communityStepTemplateCatalogue is a copy of the community step template library that is read by the provider
+// instead of the community library synced by the Octopus Server, for instances without access to the internet.
+type communityStepTemplateCatalogue struct {
+	source    string
+	templates []*actions.CommunityActionTemplate
+}
+
+const communityStepTemplateCatalogueTimeout = 60 * time.Second
+
+// catalogueTemplateIDProperty is the property of a step template installed from a catalogue that records the ID of the
+// catalogue template.
+const catalogueTemplateIDProperty = "Octopus.Terraform.CommunityActionTemplateId"
+
+// getCommunityStepTemplateCatalogue returns the catalogue read from a source. A catalogue is read once by each provider
+// instance, as it is checked for every step template installed from it when planning.
+func (c *Config) getCommunityStepTemplateCatalogue(ctx context.Context, source string) (*communityStepTemplateCatalogue, error) {
+	if c == nil {
+		return loadCommunityStepTemplateCatalogue(ctx, tracing.NewHTTPClient(), source)
+	}
+
+	if cached, ok := c.communityStepTemplateCatalogues.Load(source); ok {
+		return cached.(*communityStepTemplateCatalogue), nil
+	}
+
+	catalogue, err := loadCommunityStepTemplateCatalogue(ctx, tracing.NewHTTPClient(), source)
+	if err != nil {
+		return nil, err
+	}
+
+	c.communityStepTemplateCatalogues.Store(source, catalogue)
+	return catalogue, nil
+}
+
+// loadCommunityStepTemplateCatalogue reads a catalogue from a JSON file, a directory of JSON files or an http(s) URL,
+// which is downloaded with the HTTP client. A JSON document is a single template, an array of templates or a page of
+// templates with an Items array, which covers the files of the community library repository and exports of the
+// community action templates API.
+func loadCommunityStepTemplateCatalogue(ctx context.Context, httpClient *http.Client, source string) (*communityStepTemplateCatalogue, error) {
+	catalogue := &communityStepTemplateCatalogue{source: source}
+
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		document, err := downloadCommunityStepTemplateCatalogue(ctx, httpClient, source)
+		if err != nil {
+			return nil, err
+		}
+		return catalogue, catalogue.add(source, document)
+	}
+
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read community step template catalogue: %w", err)
+	}
+
+	files := []string{source}
+	if info.IsDir() {
+		files, err = filepath.Glob(filepath.Join(source, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		slices.Sort(files)
+	}
+
+	for _, file := range files {
+		document, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read community step template catalogue: %w", err)
+		}
+		if err := catalogue.add(file, document); err != nil {
+			return nil, err
+		}
+	}
+
+	return catalogue, nil
+}
+
+func downloadCommunityStepTemplateCatalogue(ctx context.Context, httpClient *http.Client, url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, communityStepTemplateCatalogueTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "application/json")
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("unable to download community step template catalogue: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to download community step template catalogue from %s: %s", url, response.Status)
+	}
+	return io.ReadAll(response.Body)
+}
+
+// add adds the templates of a JSON document to the catalogue. Documents that are not step templates, such as other
+// JSON files in a directory, are ignored.
+func (c *communityStepTemplateCatalogue) add(name string, document []byte) error {
+	var templates []*actions.CommunityActionTemplate
+
+	trimmed := bytes.TrimSpace(document)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("[")):
+		if err := json.Unmarshal(trimmed, &templates); err != nil {
+			return fmt.Errorf("unable to parse community step template catalogue %s: %w", name, err)
+		}
+	default:
+		var page struct {
+			Items []*actions.CommunityActionTemplate `json:"Items"`
+		}
+		if err := json.Unmarshal(trimmed, &page); err != nil {
+			return fmt.Errorf("unable to parse community step template catalogue %s: %w", name, err)
+		}
+		templates = page.Items
+
+		if page.Items == nil {
+			var template actions.CommunityActionTemplate
+			if err := json.Unmarshal(trimmed, &template); err != nil {
+				return fmt.Errorf("unable to parse community step template catalogue %s: %w", name, err)
+			}
+			templates = []*actions.CommunityActionTemplate{&template}
+		}
+	}
+
+	for _, template := range templates {
+		if template == nil || template.ActionType == "" {
+			continue
+		}
+		c.templates = append(c.templates, template)
+	}
+	return nil
+}
+
+// find returns the template with an ID or external ID.
+func (c *communityStepTemplateCatalogue) find(id string) (*actions.CommunityActionTemplate, error) {
+	for _, template := range c.templates {
+		if hasCommunityStepTemplateID(template, id) {
+			return template, nil
+		}
+	}
+	return nil, fmt.Errorf("community step template %s is not in the catalogue %s", id, c.source)
+}
+
+// hasCommunityStepTemplateID returns whether a template has an ID or external ID. The external ID of a community action
+// template synced by the Octopus Server is the ID of the template in the community library.
+func hasCommunityStepTemplateID(template *actions.CommunityActionTemplate, id string) bool {
+	return template.GetID() == id || (template.ExternalId != nil && strings.EqualFold(template.ExternalId.String(), id))
+}
+
+// newActionTemplateFromCatalogue returns the step template a catalogue template is installed as.
+func newActionTemplateFromCatalogue(spaceID string, communityActionTemplateID string, template *actions.CommunityActionTemplate) *actiontemplates.ActionTemplate {
+	actionTemplate := actiontemplates.NewActionTemplate(template.Name, template.ActionType)
+	actionTemplate.SpaceID = spaceID
+	actionTemplate.CommunityActionTemplateID = communityActionTemplateID
+	updateActionTemplateFromCatalogue(actionTemplate, communityActionTemplateID, template)
+	return actionTemplate
+}
+
+// updateActionTemplateFromCatalogue replaces the content of an installed step template with the content of a catalogue
+// template, and records the ID of the catalogue template in a property.
+func updateActionTemplateFromCatalogue(actionTemplate *actiontemplates.ActionTemplate, communityActionTemplateID string, template *actions.CommunityActionTemplate) {
+	actionTemplate.Name = template.Name
+	actionTemplate.Description = template.Description
+	actionTemplate.ActionType = template.ActionType
+	actionTemplate.Parameters = slices.Clone(template.Parameters)
+	actionTemplate.Packages = slices.Clone(template.Packages)
+	actionTemplate.Properties = maps.Clone(template.Properties)
+	if actionTemplate.Properties == nil {
+		actionTemplate.Properties = map[string]core.PropertyValue{}
+	}
+	actionTemplate.Properties[catalogueTemplateIDProperty] = core.NewPropertyValue(communityActionTemplateID, false)
+}
+
+// installedCommunityActionTemplateID is the ID of the community template that a step template was installed from. The
+// Octopus Server only keeps the community action template ID of templates in the community library that it synced,
+// which an instance without access to the internet doesn't have, so the ID of a catalogue template is read from the
+// property that records it.
+func installedCommunityActionTemplateID(actionTemplate *actiontemplates.ActionTemplate) string {
+	if actionTemplate.CommunityActionTemplateID != "" {
+		return actionTemplate.CommunityActionTemplateID
+	}
+	return actionTemplate.Properties[catalogueTemplateIDProperty].Value
+}
@@ -0,0 +1,177 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const libraryStepTemplate = `{
+  "Id": "04a74a00-967d-496a-a966-1acd17fededf",
+  "Name": "HTTP - Test URL",
+  "Description": "Makes a GET request to a URL",
+  "ActionType": "Octopus.Script",
+  "Version": 7,
+  "Properties": {"Octopus.Action.Script.ScriptBody": "Invoke-WebRequest $Uri"},
+  "Parameters": [{"Id": "a1", "Name": "Uri", "Label": "URI", "DefaultValue": null, "DisplaySettings": {"Octopus.ControlType": "SingleLineText"}}],
+  "$Meta": {"Type": "ActionTemplate"}
+}`
+
+const exportedStepTemplates = `{"Items": [{
+  "Id": "CommunityActionTemplates-12",
+  "ExternalId": "6042d737-5902-0729-ae57-8b6650a299da",
+  "Name": "Slack - Send Simple Notification",
+  "ActionType": "Octopus.Script",
+  "Version": 3,
+  "Website": "https://library.octopus.com/step-templates/6042d737-5902-0729-ae57-8b6650a299da",
+  "Parameters": []
+}]}`
+
+func TestLoadCommunityStepTemplateCatalogueFromDirectory(t *testing.T) {
+	directory := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "http-test-url.json"), []byte(libraryStepTemplate), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "export.json"), []byte(exportedStepTemplates), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "package.json"), []byte(`{"name": "library"}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "README.md"), []byte("# Library"), 0o600))
+
+	catalogue, err := loadCommunityStepTemplateCatalogue(context.Background(), http.DefaultClient, directory)
+	require.NoError(t, err)
+	assert.Len(t, catalogue.templates, 2, "documents that are not step templates are ignored")
+
+	template, err := catalogue.find("04a74a00-967d-496a-a966-1acd17fededf")
+	require.NoError(t, err)
+	assert.Equal(t, int32(7), template.Version)
+	assert.Equal(t, "Invoke-WebRequest $Uri", template.Properties["Octopus.Action.Script.ScriptBody"].Value)
+
+	template, err = catalogue.find("6042D737-5902-0729-AE57-8B6650A299DA")
+	require.NoError(t, err, "templates exported from the Octopus Server are found by the ID of the community library")
+	assert.Equal(t, "CommunityActionTemplates-12", template.GetID())
+
+	_, err = catalogue.find("CommunityActionTemplates-99")
+	assert.ErrorContains(t, err, "community step template CommunityActionTemplates-99 is not in the catalogue")
+}
+
+func TestLoadCommunityStepTemplateCatalogueFromURL(t *testing.T) {
+	var downloads atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/step-templates.json" {
+			http.NotFound(w, r)
+			return
+		}
+		downloads.Add(1)
+		_, _ = w.Write([]byte("[" + libraryStepTemplate + "]"))
+	}))
+	defer server.Close()
+
+	config := &Config{}
+	catalogue, err := config.getCommunityStepTemplateCatalogue(context.Background(), server.URL+"/step-templates.json")
+	require.NoError(t, err)
+	require.Len(t, catalogue.templates, 1)
+
+	actionTemplate := newActionTemplateFromCatalogue("Spaces-1", "04a74a00-967d-496a-a966-1acd17fededf", catalogue.templates[0])
+	assert.Equal(t, "HTTP - Test URL", actionTemplate.Name)
+	assert.Equal(t, "04a74a00-967d-496a-a966-1acd17fededf", actionTemplate.CommunityActionTemplateID)
+	assert.Equal(t, "Uri", actionTemplate.Parameters[0].Name)
+
+	cached, err := config.getCommunityStepTemplateCatalogue(context.Background(), server.URL+"/step-templates.json")
+	require.NoError(t, err)
+	assert.Same(t, catalogue, cached)
+	assert.Equal(t, int32(1), downloads.Load(), "the catalogue is downloaded once by each provider instance")
+
+	_, err = config.getCommunityStepTemplateCatalogue(context.Background(), server.URL+"/missing.json")
+	assert.ErrorContains(t, err, "404 Not Found")
+}
+
+func TestInstalledCommunityActionTemplateID(t *testing.T) {
+	catalogue := &communityStepTemplateCatalogue{}
+	require.NoError(t, catalogue.add("http-test-url.json", []byte(libraryStepTemplate)))
+
+	actionTemplate := newActionTemplateFromCatalogue("Spaces-1", "04a74a00-967d-496a-a966-1acd17fededf", catalogue.templates[0])
+	assert.Equal(t, "04a74a00-967d-496a-a966-1acd17fededf", actionTemplate.Properties[catalogueTemplateIDProperty].Value)
+	assert.NotContains(t, catalogue.templates[0].Properties, catalogueTemplateIDProperty, "the cached catalogue is not changed")
+
+	actionTemplate.CommunityActionTemplateID = ""
+	assert.Equal(t, "04a74a00-967d-496a-a966-1acd17fededf", installedCommunityActionTemplateID(actionTemplate), "the property is read when the server drops the ID")
+
+	installed := actiontemplates.NewActionTemplate("HTTP - Test URL", "Octopus.Script")
+	installed.CommunityActionTemplateID = "CommunityActionTemplates-12"
+	assert.Equal(t, "CommunityActionTemplates-12", installedCommunityActionTemplateID(installed))
+}
+
+// TestInstallCommunityStepTemplateFromCatalogueWithoutCommunityLibrary installs a catalogue template on a server without
+// a synced community library, which drops the community action template ID.
+func TestInstallCommunityStepTemplateFromCatalogueWithoutCommunityLibrary(t *testing.T) {
+	ctx := context.Background()
+	var installed map[string]any
+	octopus := newTestOctopusClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/Spaces-1/actiontemplates":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&installed))
+			delete(installed, "CommunityActionTemplateId")
+			for _, parameter := range installed["Parameters"].([]any) {
+				// The server returns an empty default value for parameters without one
+				if parameter.(map[string]any)["DefaultValue"] == nil {
+					parameter.(map[string]any)["DefaultValue"] = ""
+				}
+			}
+			installed["Id"] = "ActionTemplates-1"
+			installed["Version"] = 1
+		case r.Method == http.MethodGet && r.URL.Path == "/api/Spaces-1/actiontemplates/ActionTemplates-1":
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(installed))
+	})
+
+	source := filepath.Join(t.TempDir(), "http-test-url.json")
+	require.NoError(t, os.WriteFile(source, []byte(libraryStepTemplate), 0o600))
+
+	resourceSchema := schemas.CommunityStepTemplateSchema{}.GetResourceSchema()
+	plan := tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}
+	require.False(t, plan.Set(ctx, &schemas.StepTemplateFromCommunityStepTemplateTypeResourceModel{
+		CommunityActionTemplateId: types.StringValue("04a74a00-967d-496a-a966-1acd17fededf"),
+		CatalogueSource:           types.StringValue(source),
+		CatalogueVersion:          types.Int32Unknown(),
+		SpaceID:                   types.StringUnknown(),
+		Name:                      types.StringUnknown(),
+		Description:               types.StringUnknown(),
+		ActionType:                types.StringUnknown(),
+		Version:                   types.Int32Unknown(),
+		Packages:                  types.ListUnknown(resourceSchema.Attributes["packages"].GetType().(types.ListType).ElemType),
+		Parameters:                types.ListUnknown(resourceSchema.Attributes["parameters"].GetType().(types.ListType).ElemType),
+		Properties:                types.MapUnknown(types.StringType),
+		ResourceModel:             schemas.ResourceModel{ID: types.StringUnknown()},
+	}).HasError())
+
+	r := &communityStepTemplateTypeResource{Config: &Config{Client: octopus}}
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: resourceSchema, Raw: plan.Raw}}, createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	var state schemas.StepTemplateFromCommunityStepTemplateTypeResourceModel
+	require.False(t, createResp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "ActionTemplates-1", state.ID.ValueString())
+	assert.Equal(t, "04a74a00-967d-496a-a966-1acd17fededf", state.CommunityActionTemplateId.ValueString())
+	assert.Equal(t, int32(7), state.CatalogueVersion.ValueInt32())
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	require.False(t, readResp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "04a74a00-967d-496a-a966-1acd17fededf", state.CommunityActionTemplateId.ValueString(), "the ID is read back from the property")
+}
@@ -6,23 +6,62 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/configuration"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/spaces"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/credentials"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/tracing"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"go/version"
 	"net/url"
+	"strings"
+	"sync"
 )
 
 type Config struct {
-	Address        string
-	ApiKey         string
-	AccessToken    string
-	SpaceID        string
-	Client         *client.Client
-	OctopusVersion string
+	Address           string
+	ApiKey            string
+	AccessToken       string
+	CredentialCommand []string
+	SpaceID           string
+	SpaceName         string
+	Client            *client.Client
+	OctopusVersion    string
 	// Can be nil when server doesn't support feature toggles API endpoint
 	FeatureToggles map[string]bool
+	// Offline is set when there is no connection to the Octopus Server to check the server version and feature
+	// toggles against, e.g. when the provider configuration is not known until apply
+	Offline bool
+
+	connect      sync.Once
+	connectDiags diag.Diagnostics
+
+	// variableDefinitions are the variable definitions of the projects analysed in a plan, by project ID
+	variableDefinitions sync.Map
+
+	// variableScopeEntities are the entities the variables of an owner can be scoped to, by space and owner ID
+	variableScopeEntities sync.Map
+
+	// communityStepTemplateCatalogues are the community step template catalogues read by the provider, by source
+	communityStepTemplateCatalogues sync.Map
+}
+
+// Connect creates the client the first time a resource or data source needs it, so the provider can be configured
+// without a reachable Octopus Server.
+func (c *Config) Connect(ctx context.Context) diag.Diagnostics {
+	c.connect.Do(func() {
+		if c.Offline {
+			return
+		}
+
+		c.connectDiags = c.SetOctopus(ctx)
+	})
+
+	return c.connectDiags
 }
 
 func (c *Config) SetOctopus(ctx context.Context) diag.Diagnostics {
@@ -54,6 +93,18 @@ func (c *Config) GetClient(ctx context.Context) error {
 		return err
 	}
 
+	if len(c.SpaceID) > 0 && len(c.SpaceName) > 0 {
+		return fmt.Errorf("only one of space_id or space_name can be specified")
+	}
+
+	if len(c.SpaceName) > 0 {
+		space, err := getSpaceByName(octopus, c.SpaceName)
+		if err != nil {
+			return err
+		}
+		c.SpaceID = space.GetID()
+	}
+
 	if len(c.SpaceID) > 0 {
 		space, err := spaces.GetByID(octopus, c.SpaceID)
 		if err != nil {
@@ -122,11 +173,47 @@ func getClientForSpace(c *Config, ctx context.Context, spaceID string) (*client.
 		return nil, err
 	}
 
-	return client.NewClientWithCredentials(nil, apiURL, credential, spaceID, "TerraformProvider")
+	httpClient := tracing.NewHTTPClient()
+	if commandCredential, ok := credential.(*credentials.CommandCredential); ok {
+		httpClient.Transport = commandCredential.Transport(httpClient.Transport)
+	}
+
+	return client.NewClientWithCredentials(httpClient, apiURL, credential, spaceID, "TerraformProvider")
+}
+
+func getSpaceByName(octopus *client.Client, name string) (*spaces.Space, error) {
+	allSpaces, err := spaces.GetAll(octopus)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, space := range allSpaces {
+		if strings.EqualFold(space.Name, name) {
+			return space, nil
+		}
+	}
+
+	return nil, fmt.Errorf("the space '%s' cannot be found", name)
+}
+
+// GetSpaceName returns the name of the space the provider is configured with.
+func (c *Config) GetSpaceName() (string, error) {
+	if c.SpaceName != "" {
+		return c.SpaceName, nil
+	}
+	if c.SpaceID == "" {
+		return "", fmt.Errorf("the provider is not configured with a space")
+	}
+
+	space, err := spaces.GetByID(c.Client, c.SpaceID)
+	if err != nil {
+		return "", err
+	}
+	return space.Name, nil
 }
 
 func getApiCredential(c *Config, ctx context.Context) (client.ICredential, error) {
-	tflog.Debug(ctx, "GetClient: Trying the following auth methods in order of priority - APIKey, AccessToken")
+	tflog.Debug(ctx, "GetClient: Trying the following auth methods in order of priority - APIKey, AccessToken, CredentialCommand")
 
 	if c.ApiKey != "" {
 		tflog.Debug(ctx, "GetClient: Attempting to authenticate with API Key")
@@ -152,10 +239,22 @@ func getApiCredential(c *Config, ctx context.Context) (client.ICredential, error
 		tflog.Debug(ctx, "GetClient: No Access Token found")
 	}
 
-	return nil, fmt.Errorf("either an APIKey or an AccessToken is required to connect to the Octopus Server instance")
+	if len(c.CredentialCommand) > 0 {
+		tflog.Debug(ctx, "GetClient: Attempting to authenticate with Credential Command")
+		credential := credentials.NewCommandCredential(c.CredentialCommand)
+		if _, err := credential.Refresh(ctx); err != nil {
+			return nil, err
+		}
+
+		return credential, nil
+	} else {
+		tflog.Debug(ctx, "GetClient: No Credential Command found")
+	}
+
+	return nil, fmt.Errorf("either an APIKey, an AccessToken or a credential command is required to connect to the Octopus Server instance")
 }
 
-func DataSourceConfiguration(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *Config {
+func DataSourceConfiguration(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *Config {
 	if req.ProviderData == nil {
 		return nil
 	}
@@ -169,10 +268,15 @@ func DataSourceConfiguration(req datasource.ConfigureRequest, resp *datasource.C
 		return nil
 	}
 
+	resp.Diagnostics.Append(config.Connect(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+
 	return config
 }
 
-func ResourceConfiguration(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *Config {
+func ResourceConfiguration(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) *Config {
 	if req.ProviderData == nil {
 		return nil
 	}
@@ -186,6 +290,11 @@ func ResourceConfiguration(req resource.ConfigureRequest, resp *resource.Configu
 		return nil
 	}
 
+	resp.Diagnostics.Append(config.Connect(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+
 	return config
 }
 
@@ -207,6 +316,13 @@ func (c *Config) FeatureToggleEnabled(toggle string) bool {
 func (c *Config) EnsureResourceCompatibilityByFeature(resourceName string, toggle string) diag.Diagnostics {
 	diags := diag.Diagnostics{}
 
+	if c.Offline {
+		summary := fmt.Sprintf("The '%s' resource could not be checked against the Octopus Deploy instance", resourceName)
+		detail := fmt.Sprintf("This resource requires feature toggle '%s' to be enabled. The provider is not connected to an Octopus Server, so this is checked when the resource is applied.", toggle)
+		diags.AddWarning(summary, detail)
+		return diags
+	}
+
 	if c.FeatureToggleEnabled(toggle) {
 		return diags
 	}
@@ -225,6 +341,13 @@ func (c *Config) EnsureResourceCompatibilityByFeature(resourceName string, toggl
 func (c *Config) EnsureResourceCompatibilityByVersion(resourceName string, version string) diag.Diagnostics {
 	diags := diag.Diagnostics{}
 
+	if c.Offline {
+		summary := fmt.Sprintf("The '%s' resource could not be checked against the Octopus Deploy server version", resourceName)
+		detail := fmt.Sprintf("This resource requires Octopus Deploy server version %s or later. The provider is not connected to an Octopus Server, so this is checked when the resource is applied.", version)
+		diags.AddWarning(summary, detail)
+		return diags
+	}
+
 	if c.IsVersionSameOrGreaterThan(version) {
 		return diags
 	}
@@ -236,11 +359,63 @@ func (c *Config) EnsureResourceCompatibilityByVersion(resourceName string, versi
 	return diags
 }
 
+// EnsureAttributeCompatibility Reports attributes set in the configuration that the connected Octopus Server cannot honour,
+// using the server requirements declared on the resource schema with MinServerVersion and RequiresFeature.
+// Returns diagnostics with an error for each incompatible attribute, to be appended in the resource ModifyPlan
+func (c *Config) EnsureAttributeCompatibility(ctx context.Context, resourceName string, config tfsdk.Config) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	resourceSchema, ok := config.Schema.(schema.Schema)
+	if c == nil || !ok {
+		return diags
+	}
+
+	for _, requirement := range util.GetServerRequirements(resourceSchema) {
+		configured, pathDiags := util.GetConfiguredPaths(ctx, config, requirement.Expression)
+		diags.Append(pathDiags...)
+
+		for _, attributePath := range configured {
+			diags.Append(c.ensureServerRequirement(resourceName, attributePath, requirement.Requirement)...)
+		}
+	}
+
+	return diags
+}
+
+func (c *Config) ensureServerRequirement(resourceName string, attributePath path.Path, requirement util.ServerRequirement) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if c.Offline {
+		summary := fmt.Sprintf("The '%s' attribute of the '%s' resource could not be checked against the Octopus Deploy instance", attributePath, resourceName)
+		detail := fmt.Sprintf("This attribute %s. The provider is not connected to an Octopus Server, so this is checked when the resource is applied.", requirement.Description(context.Background()))
+		diags.AddAttributeWarning(attributePath, summary, detail)
+		return diags
+	}
+
+	if requirement.MinVersion != "" && !c.IsVersionSameOrGreaterThan(requirement.MinVersion) {
+		summary := fmt.Sprintf("The '%s' attribute of the '%s' resource is not supported by the current Octopus Deploy server version", attributePath, resourceName)
+		detail := fmt.Sprintf("This attribute requires Octopus Deploy server version %s or later. The connected server is running version %s. Remove the attribute or upgrade the server.", requirement.MinVersion, c.OctopusVersion)
+		diags.AddAttributeError(attributePath, summary, detail)
+	}
+
+	if requirement.Feature != "" && !c.FeatureToggleEnabled(requirement.Feature) {
+		summary := fmt.Sprintf("The '%s' attribute of the '%s' resource is not supported by the connected Octopus Deploy instance", attributePath, resourceName)
+		detail := fmt.Sprintf("This attribute requires feature toggle '%s' to be enabled. Remove the attribute or enable the feature on the server.", requirement.Feature)
+		diags.AddAttributeError(attributePath, summary, detail)
+	}
+
+	return diags
+}
+
 func (c *Config) IsVersionSameOrGreaterThan(minVersion string) bool {
 	if c.OctopusVersion == "0.0.0-local" {
 		return true // Always true for local instance
 	}
 
+	if c.Offline {
+		return true // Assume a current server until there is one to check
+	}
+
 	diff := version.Compare(fmt.Sprintf("go%s", c.OctopusVersion), fmt.Sprintf("go%s", minVersion))
 
 	return diff == 1 || diff == 0
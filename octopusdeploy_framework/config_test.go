@@ -1,7 +1,15 @@
 package octopusdeploy_framework
 
 import (
+	"context"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
+	"math/big"
 	"testing"
 )
 
@@ -86,3 +94,81 @@ func testFeatureToggleShouldFail(t *testing.T, features map[string]bool, feature
 
 	assert.True(t, diags.HasError(), "Expected feature %q to be disabled", feature)
 }
+
+func TestEnsureResourceCompatibilityWhenOfflineWarns(t *testing.T) {
+	configuration := Config{Offline: true}
+
+	versionDiags := configuration.EnsureResourceCompatibilityByVersion("offline_resource_name", "2025.3")
+	assert.False(t, versionDiags.HasError())
+	assert.Equal(t, 1, versionDiags.WarningsCount())
+
+	featureDiags := configuration.EnsureResourceCompatibilityByFeature("offline_resource_name", "non-existing-feature-toggle")
+	assert.False(t, featureDiags.HasError())
+	assert.Equal(t, 1, featureDiags.WarningsCount())
+
+	assert.True(t, configuration.IsVersionSameOrGreaterThan("2025.3"))
+}
+
+func TestConnectWhenOfflineDoesNotCreateClient(t *testing.T) {
+	configuration := Config{Offline: true}
+
+	diags := configuration.Connect(context.Background())
+
+	assert.False(t, diags.HasError())
+	assert.Nil(t, configuration.Client)
+}
+
+func TestEnsureAttributeCompatibility(t *testing.T) {
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":     util.ResourceString().Optional().Build(),
+			"priority": util.ResourceInt64().Optional().MinServerVersion("2025.2").Build(),
+		},
+		Blocks: map[string]schema.Block{
+			"scope": schema.ListNestedBlock{
+				Validators: []validator.List{util.RequiresFeature("ScopingFeatureToggle")},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"environment_ids": util.ResourceSet(types.StringType).Optional().Build(),
+					},
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	scopeType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"environment_ids": tftypes.Set{ElementType: tftypes.String}}}
+	newConfig := func(priority any, scopes []tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: resourceSchema,
+			Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"name":     tftypes.NewValue(tftypes.String, "example"),
+				"priority": tftypes.NewValue(tftypes.Number, priority),
+				"scope":    tftypes.NewValue(tftypes.List{ElementType: scopeType}, scopes),
+			}),
+		}
+	}
+	scope := tftypes.NewValue(scopeType, map[string]tftypes.Value{
+		"environment_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "Environments-1")}),
+	})
+
+	oldServer := &Config{OctopusVersion: "2025.1", FeatureToggles: map[string]bool{}}
+	newServer := &Config{OctopusVersion: "2025.2", FeatureToggles: map[string]bool{"ScopingFeatureToggle": true}}
+
+	diags := oldServer.EnsureAttributeCompatibility(ctx, "example", newConfig(nil, []tftypes.Value{}))
+	assert.False(t, diags.HasError(), "Attributes that are not set should not be checked")
+
+	diags = oldServer.EnsureAttributeCompatibility(ctx, "example", newConfig(big.NewFloat(10), []tftypes.Value{scope}))
+	assert.Equal(t, 2, diags.ErrorsCount())
+
+	diags = newServer.EnsureAttributeCompatibility(ctx, "example", newConfig(big.NewFloat(10), []tftypes.Value{scope}))
+	assert.False(t, diags.HasError())
+
+	unknownToggles := &Config{OctopusVersion: "2025.2"}
+	diags = unknownToggles.EnsureAttributeCompatibility(ctx, "example", newConfig(big.NewFloat(10), []tftypes.Value{scope}))
+	assert.False(t, diags.HasError(), "Features should be treated as enabled when the feature toggles could not be loaded")
+
+	diags = (&Config{Offline: true}).EnsureAttributeCompatibility(ctx, "example", newConfig(big.NewFloat(10), []tftypes.Value{scope}))
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 2, diags.WarningsCount())
+}
@@ -47,7 +47,7 @@ func (l *libraryVariableSetDataSource) Schema(ctx context.Context, req datasourc
 
 func (l *libraryVariableSetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	tflog.Debug(ctx, "library variable set datasource Configure")
-	l.Config = DataSourceConfiguration(req, resp)
+	l.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (l *libraryVariableSetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
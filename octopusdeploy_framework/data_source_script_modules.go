@@ -35,7 +35,7 @@ func (l *scriptModulesDataSource) Schema(ctx context.Context, req datasource.Sch
 
 func (l *scriptModulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	tflog.Debug(ctx, "script modules datasource Configure")
-	l.Config = DataSourceConfiguration(req, resp)
+	l.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (l *scriptModulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
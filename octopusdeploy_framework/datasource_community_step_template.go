@@ -31,8 +31,8 @@ func (*communityStepTemplateDataSource) Schema(_ context.Context, req datasource
 }
 
 // The Configure function gives you access to a client used to interact with the Octopus Deploy API.
-func (d *communityStepTemplateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	d.Config = DataSourceConfiguration(req, resp)
+func (d *communityStepTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 // Read access the Octopus Deploy API to retrieve community step templates based on the provided configuration.
@@ -45,14 +45,15 @@ func (d *communityStepTemplateDataSource) Read(ctx context.Context, req datasour
 	}
 
 	query := struct {
-		ID      string
-		Website string
-		Name    string
-	}{data.ID.ValueString(), data.Website.ValueString(), data.Name.ValueString()}
+		ID              string
+		Website         string
+		Name            string
+		CatalogueSource string
+	}{data.ID.ValueString(), data.Website.ValueString(), data.Name.ValueString(), data.CatalogueSource.ValueString()}
 
 	util.DatasourceReading(ctx, "community_step_templates", query)
 
-	communityStepTemplates, err := d.getCommunityStepTemplate(query.ID)
+	communityStepTemplates, err := d.getCommunityStepTemplate(ctx, query.ID, query.CatalogueSource)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to query community step templates", err.Error())
@@ -62,7 +63,7 @@ func (d *communityStepTemplateDataSource) Read(ctx context.Context, req datasour
 	matchingCommunityStepTemplates := []*actions.CommunityActionTemplate{}
 
 	for _, communityStepTemplate := range communityStepTemplates {
-		if strings.TrimSpace(query.ID) != "" && communityStepTemplate.ID != query.ID {
+		if strings.TrimSpace(query.ID) != "" && !hasCommunityStepTemplateID(communityStepTemplate, query.ID) {
 			continue
 		}
 		if strings.TrimSpace(query.Website) != "" && communityStepTemplate.Website != query.Website {
@@ -92,7 +93,15 @@ func (d *communityStepTemplateDataSource) Read(ctx context.Context, req datasour
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (d *communityStepTemplateDataSource) getCommunityStepTemplate(id string) ([]*actions.CommunityActionTemplate, error) {
+func (d *communityStepTemplateDataSource) getCommunityStepTemplate(ctx context.Context, id string, catalogueSource string) ([]*actions.CommunityActionTemplate, error) {
+	if catalogueSource != "" {
+		catalogue, err := d.Config.getCommunityStepTemplateCatalogue(ctx, catalogueSource)
+		if err != nil {
+			return nil, err
+		}
+		return catalogue.templates, nil
+	}
+
 	queryIds := []string{}
 
 	if strings.TrimSpace(id) != "" {
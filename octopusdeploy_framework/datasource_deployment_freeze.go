@@ -32,8 +32,8 @@ type deploymentFreezeDataSource struct {
 	*Config
 }
 
-func (d *deploymentFreezeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	d.Config = DataSourceConfiguration(req, resp)
+func (d *deploymentFreezeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func NewDeploymentFreezeDataSource() datasource.DataSource {
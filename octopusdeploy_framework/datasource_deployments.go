@@ -0,0 +1,137 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/dashboard"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type deploymentsDataSource struct {
+	*Config
+}
+
+// projectDashboard is the part of the dashboard of a project that the deployments data source uses.
+type projectDashboard struct {
+	Items []*dashboard.DashboardItem `json:"Items"`
+}
+
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &deploymentsDataSource{}
+}
+
+func (d *deploymentsDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.DeploymentsDataSourceName)
+}
+
+func (d *deploymentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.DeploymentsSchema{}.GetDatasourceSchema()
+}
+
+func (d *deploymentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = DataSourceConfiguration(ctx, req, resp)
+}
+
+func (d *deploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schemas.DeploymentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := data.SpaceID.ValueString()
+	if spaceID == "" {
+		spaceID = d.Client.GetSpaceID()
+	}
+	environmentIDs := util.ExpandStringList(data.EnvironmentIDs)
+	tenantIDs := util.ExpandStringList(data.TenantIDs)
+
+	util.DatasourceReading(ctx, "deployments", data.ProjectID.ValueString())
+
+	projectDashboard, err := newclient.Get[projectDashboard](d.Client.HttpSession(), getProjectDashboardPath(spaceID, data.ProjectID.ValueString(), environmentIDs))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to load deployments", err.Error())
+		return
+	}
+
+	deploymentModels := flattenDashboardItems(projectDashboard.Items, data.ProjectID.ValueString(), environmentIDs, tenantIDs)
+
+	util.DatasourceResultCount(ctx, "deployments", len(deploymentModels))
+
+	deploymentList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: schemas.DeploymentObjectType()}, deploymentModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SpaceID = types.StringValue(spaceID)
+	data.Deployments = deploymentList
+	data.ID = types.StringValue("Deployments " + time.Now().UTC().String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getProjectDashboardPath returns the path of the dynamic dashboard of a project. Unlike the dashboard, the dynamic
+// dashboard includes the deployment before the current deployment to each environment and tenant. It can't be filtered
+// by tenant, so the items are filtered by tenant once they are read.
+func getProjectDashboardPath(spaceID string, projectID string, environmentIDs []string) string {
+	parameters := url.Values{"projects": {projectID}, "includePrevious": {"true"}}
+	if len(environmentIDs) > 0 {
+		parameters["environments"] = environmentIDs
+	}
+	return fmt.Sprintf("/api/%s/dashboard/dynamic?%s", spaceID, parameters.Encode())
+}
+
+// flattenDashboardItems converts the dashboard items of a project to deployments, keeping the items of the environments
+// and tenants in the filters. An empty filter keeps every item.
+func flattenDashboardItems(items []*dashboard.DashboardItem, projectID string, environmentIDs []string, tenantIDs []string) []schemas.DeploymentModel {
+	deployments := make([]schemas.DeploymentModel, 0, len(items))
+	for _, item := range items {
+		if item.ProjectID != projectID {
+			continue
+		}
+		if len(environmentIDs) > 0 && !slices.Contains(environmentIDs, item.EnvironmentID) {
+			continue
+		}
+		if len(tenantIDs) > 0 && !slices.Contains(tenantIDs, item.TenantID) {
+			continue
+		}
+
+		deployments = append(deployments, schemas.DeploymentModel{
+			EnvironmentID:           types.StringValue(item.EnvironmentID),
+			TenantID:                util.StringOrNull(item.TenantID),
+			ChannelID:               types.StringValue(item.ChannelID),
+			ReleaseID:               types.StringValue(item.ReleaseID),
+			ReleaseVersion:          types.StringValue(item.ReleaseVersion),
+			DeploymentID:            types.StringValue(item.DeploymentID),
+			TaskID:                  types.StringValue(item.TaskID),
+			State:                   types.StringValue(item.State),
+			ErrorMessage:            util.StringOrNull(item.ErrorMessage),
+			IsCompleted:             types.BoolValue(item.IsCompleted),
+			IsCurrent:               types.BoolValue(item.IsCurrent),
+			IsPrevious:              types.BoolValue(item.IsPrevious),
+			HasPendingInterruptions: types.BoolValue(item.HasPendingInterruptions),
+			HasWarningsOrErrors:     types.BoolValue(item.HasWarningsOrErrors),
+			Created:                 flattenDashboardTime(item.Created),
+			QueueTime:               flattenDashboardTime(item.QueueTime),
+			StartTime:               flattenDashboardTime(item.StartTime),
+			CompletedTime:           flattenDashboardTime(item.CompletedTime),
+		})
+	}
+	return deployments
+}
+
+func flattenDashboardTime(t *time.Time) types.String {
+	if t == nil || t.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,48 @@
+package octopusdeploy_framework
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenDashboardItems(t *testing.T) {
+	var dashboard projectDashboard
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"Items": [
+			{"ProjectId": "Projects-1", "EnvironmentId": "Environments-1", "ReleaseVersion": "1.0.1", "State": "Success", "IsCompleted": true, "IsCurrent": true, "Created": "2024-05-01T10:30:00+10:00", "CompletedTime": "2024-05-01T10:35:00+10:00"},
+			{"ProjectId": "Projects-1", "EnvironmentId": "Environments-2", "TenantId": "Tenants-1", "ReleaseVersion": "1.0.0", "State": "Executing", "Created": "2024-05-01T11:00:00Z"},
+			{"ProjectId": "Projects-1", "EnvironmentId": "Environments-2", "TenantId": "Tenants-2", "ReleaseVersion": "0.9.0", "State": "Failed", "ErrorMessage": "Step failed", "IsCompleted": true, "IsCurrent": true},
+			{"ProjectId": "Projects-2", "EnvironmentId": "Environments-1", "ReleaseVersion": "5.0.0", "State": "Success", "IsCompleted": true, "IsCurrent": true},
+			{"ProjectId": "Projects-1", "EnvironmentId": "Environments-1", "ReleaseVersion": "1.0.0", "State": "Success", "IsCompleted": true, "IsPrevious": true}
+		]
+	}`), &dashboard))
+
+	deployments := flattenDashboardItems(dashboard.Items, "Projects-1", nil, nil)
+	require.Len(t, deployments, 4)
+	assert.Equal(t, types.StringValue("1.0.1"), deployments[0].ReleaseVersion)
+	assert.Equal(t, types.BoolValue(false), deployments[0].IsPrevious)
+	assert.Equal(t, types.StringValue("1.0.0"), deployments[3].ReleaseVersion)
+	assert.Equal(t, types.BoolValue(true), deployments[3].IsPrevious)
+	assert.True(t, deployments[0].TenantID.IsNull())
+	assert.Equal(t, types.StringValue("2024-05-01T00:30:00Z"), deployments[0].Created)
+	assert.Equal(t, types.StringValue("2024-05-01T00:35:00Z"), deployments[0].CompletedTime)
+	assert.True(t, deployments[0].StartTime.IsNull())
+	assert.True(t, deployments[0].ErrorMessage.IsNull())
+	assert.Equal(t, types.StringValue("Executing"), deployments[1].State)
+	assert.Equal(t, types.BoolValue(false), deployments[1].IsCompleted)
+	assert.Equal(t, types.StringValue("Step failed"), deployments[2].ErrorMessage)
+
+	deployments = flattenDashboardItems(dashboard.Items, "Projects-1", []string{"Environments-2"}, []string{"Tenants-2"})
+	require.Len(t, deployments, 1)
+	assert.Equal(t, types.StringValue("0.9.0"), deployments[0].ReleaseVersion)
+}
+
+func TestGetProjectDashboardPath(t *testing.T) {
+	assert.Equal(t, "/api/Spaces-1/dashboard/dynamic?includePrevious=true&projects=Projects-1", getProjectDashboardPath("Spaces-1", "Projects-1", nil))
+	assert.Equal(t, "/api/Spaces-1/dashboard/dynamic?environments=Environments-1&environments=Environments-2&includePrevious=true&projects=Projects-1",
+		getProjectDashboardPath("Spaces-1", "Projects-1", []string{"Environments-1", "Environments-2"}))
+}
@@ -0,0 +1,109 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &deprecationsDataSource{}
+
+// deprecationsDataSource reports the deprecations declared in the schemas of the plugin framework resources. It
+// does not need a connection to an Octopus Server, so it is not configured with the provider data.
+type deprecationsDataSource struct{}
+
+func NewDeprecationsDataSource() datasource.DataSource {
+	return &deprecationsDataSource{}
+}
+
+func (d *deprecationsDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.DeprecationsDataSourceName)
+}
+
+func (d *deprecationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.DeprecationsSchema{}.GetDatasourceSchema()
+}
+
+func (d *deprecationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schemas.DeprecationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.DatasourceReading(ctx, "deprecations", data.ResourceType.ValueString())
+
+	data.Deprecations = []schemas.DeprecationDatasourceModel{}
+	for _, newResource := range NewOctopusDeployFrameworkProvider().Resources(ctx) {
+		deprecations := getResourceDeprecations(ctx, newResource())
+		for _, deprecation := range deprecations {
+			if data.ResourceType.IsNull() || data.ResourceType.ValueString() == deprecation.ResourceType.ValueString() {
+				data.Deprecations = append(data.Deprecations, deprecation)
+			}
+		}
+	}
+
+	sort.SliceStable(data.Deprecations, func(i, j int) bool {
+		if data.Deprecations[i].ResourceType.ValueString() != data.Deprecations[j].ResourceType.ValueString() {
+			return data.Deprecations[i].ResourceType.ValueString() < data.Deprecations[j].ResourceType.ValueString()
+		}
+		return data.Deprecations[i].Attribute.ValueString() < data.Deprecations[j].Attribute.ValueString()
+	})
+
+	util.DatasourceResultCount(ctx, "deprecations", len(data.Deprecations))
+
+	data.ID = types.StringValue(fmt.Sprintf("Deprecations-%s", time.Now().UTC().String()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func getResourceDeprecations(ctx context.Context, r resource.Resource) []schemas.DeprecationDatasourceModel {
+	metadata := resource.MetadataResponse{}
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: util.GetProviderName()}, &metadata)
+
+	schemaResponse := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	var deprecations []schemas.DeprecationDatasourceModel
+	if schemaResponse.Schema.DeprecationMessage != "" {
+		deprecations = append(deprecations, schemas.DeprecationDatasourceModel{
+			ResourceType:   types.StringValue(metadata.TypeName),
+			Attribute:      types.StringValue(""),
+			Message:        types.StringValue(schemaResponse.Schema.DeprecationMessage),
+			Replacement:    types.StringNull(),
+			RemovalVersion: types.StringNull(),
+			ReversalKey:    types.StringNull(),
+			Disabled:       types.BoolValue(false),
+		})
+	}
+
+	for _, found := range util.GetSchemaDeprecations(schemaResponse.Schema) {
+		deprecation := schemas.DeprecationDatasourceModel{
+			ResourceType:   types.StringValue(metadata.TypeName),
+			Attribute:      types.StringValue(found.Expression.String()),
+			Message:        types.StringValue(found.Message),
+			Replacement:    types.StringNull(),
+			RemovalVersion: types.StringNull(),
+			ReversalKey:    types.StringNull(),
+			Disabled:       types.BoolValue(false),
+		}
+
+		if found.Deprecation != nil {
+			deprecation.Replacement = util.StringOrNull(found.Deprecation.Replacement)
+			deprecation.RemovalVersion = util.StringOrNull(found.Deprecation.RemovalVersion)
+			deprecation.ReversalKey = util.StringOrNull(found.Deprecation.ReversalKey)
+			deprecation.Disabled = types.BoolValue(found.Deprecation.IsDisabled())
+		}
+
+		deprecations = append(deprecations, deprecation)
+	}
+
+	return deprecations
+}
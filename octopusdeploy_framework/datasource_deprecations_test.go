@@ -0,0 +1,73 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetResourceDeprecationsReportsProjectAttributes(t *testing.T) {
+	deprecations := getResourceDeprecations(context.Background(), NewProjectResource())
+
+	var found bool
+	for _, deprecation := range deprecations {
+		assert.Equal(t, "octopusdeploy_project", deprecation.ResourceType.ValueString())
+		if deprecation.Attribute.ValueString() == "discrete_channel_release" {
+			found = true
+			assert.Equal(t, "is_discrete_channel_release", deprecation.Replacement.ValueString())
+			assert.Equal(t, "2.0.0", deprecation.RemovalVersion.ValueString())
+			assert.Contains(t, deprecation.Message.ValueString(), "is_discrete_channel_release")
+			assert.False(t, deprecation.Disabled.ValueBool())
+		}
+	}
+	assert.True(t, found, "discrete_channel_release should be reported")
+}
+
+func TestForwardDeprecatedValues(t *testing.T) {
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"old_name": util.ResourceString().Optional().Deprecation(util.Deprecation{Replacement: "new_name", RemovalVersion: "2.0.0", Forward: true}).Build(),
+			"new_name": util.ResourceString().Optional().Computed().Build(),
+		},
+	}
+
+	ctx := context.Background()
+	objectType := resourceSchema.Type().TerraformType(ctx)
+	newValue := func(oldName any, newName any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"old_name": tftypes.NewValue(tftypes.String, oldName),
+			"new_name": tftypes.NewValue(tftypes.String, newName),
+		})
+	}
+
+	tests := []struct {
+		name     string
+		config   tftypes.Value
+		expected string
+	}{
+		{name: "deprecated attribute is forwarded", config: newValue("example", nil), expected: "example"},
+		{name: "replacement is kept when both are set", config: newValue("example", "other"), expected: "other"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.Config{Schema: resourceSchema, Raw: tt.config}
+			plan := tfsdk.Plan{Schema: resourceSchema, Raw: tt.config}
+
+			diags := util.ForwardDeprecatedValues(ctx, config, &plan)
+			require.False(t, diags.HasError(), "%v", diags)
+
+			var newName types.String
+			require.False(t, plan.GetAttribute(ctx, path.Root("new_name"), &newName).HasError())
+			assert.Equal(t, tt.expected, newName.ValueString())
+		})
+	}
+}
@@ -41,8 +41,8 @@ func (*environmentDataSource) Schema(_ context.Context, req datasource.SchemaReq
 	resp.Schema = schemas.EnvironmentSchema{}.GetDatasourceSchema()
 }
 
-func (e *environmentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	e.Config = DataSourceConfiguration(req, resp)
+func (e *environmentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	e.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (e *environmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
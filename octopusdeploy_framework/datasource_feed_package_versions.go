@@ -0,0 +1,181 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const feedPackageVersionsPageSize = 100
+
+type feedPackageVersionsDataSource struct {
+	*Config
+}
+
+// feedPackageVersionsQuery is a search of the versions of a package in a feed. The server checks the version range and
+// pre-release tag, and the provider checks the SemVer range.
+type feedPackageVersionsQuery struct {
+	spaceID           string
+	feedID            string
+	packageID         string
+	versionRange      string
+	semVerRange       *util.SemVerRange
+	includePrerelease bool
+	prereleaseTag     string
+	take              int
+}
+
+func NewFeedPackageVersionsDataSource() datasource.DataSource {
+	return &feedPackageVersionsDataSource{}
+}
+
+func (d *feedPackageVersionsDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.FeedPackageVersionsDataSourceName)
+}
+
+func (d *feedPackageVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.FeedPackageVersionsSchema{}.GetDatasourceSchema()
+}
+
+func (d *feedPackageVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = DataSourceConfiguration(ctx, req, resp)
+}
+
+func (d *feedPackageVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schemas.FeedPackageVersionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := feedPackageVersionsQuery{
+		spaceID:           data.SpaceID.ValueString(),
+		feedID:            data.FeedID.ValueString(),
+		packageID:         data.PackageID.ValueString(),
+		versionRange:      data.VersionRange.ValueString(),
+		includePrerelease: data.IncludePrerelease.ValueBool() || data.PrereleaseTag.ValueString() != "",
+		prereleaseTag:     data.PrereleaseTag.ValueString(),
+		take:              int(data.Take.ValueInt64()),
+	}
+	if query.spaceID == "" {
+		query.spaceID = d.Client.GetSpaceID()
+	}
+	if data.Latest.ValueBool() {
+		query.take = 1
+	}
+	if !data.SemVerRange.IsNull() {
+		semVerRange, err := util.ParseSemVerRange(data.SemVerRange.ValueString(), query.includePrerelease)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("semver_range"), "Invalid SemVer range", err.Error())
+			return
+		}
+		query.semVerRange = semVerRange
+	}
+
+	util.DatasourceReading(ctx, "feed package versions", query.feedID+" "+query.packageID)
+
+	versions, err := searchFeedPackageVersions(d.Client, query)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to search feed package versions", err.Error())
+		return
+	}
+
+	util.DatasourceResultCount(ctx, "feed package versions", len(versions))
+
+	versionModels := make([]schemas.FeedPackageVersionModel, 0, len(versions))
+	for _, version := range versions {
+		versionModels = append(versionModels, flattenFeedPackageVersion(version))
+	}
+
+	versionList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: schemas.FeedPackageVersionObjectType()}, versionModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SpaceID = types.StringValue(query.spaceID)
+	data.Versions = versionList
+	data.ID = types.StringValue("Feed Package Versions " + time.Now().UTC().String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// searchFeedPackageVersions pages through the versions of a package that the feed search endpoint returns, most recent
+// first, until it has found the number of versions the query takes.
+func searchFeedPackageVersions(octopus *client.Client, query feedPackageVersionsQuery) ([]*packages.PackageVersion, error) {
+	parameters := url.Values{
+		"packageId": {query.packageID},
+		"take":      {strconv.Itoa(feedPackageVersionsPageSize)},
+	}
+	if query.versionRange != "" {
+		parameters.Set("versionRange", query.versionRange)
+	}
+	if query.includePrerelease {
+		parameters.Set("includePreRelease", "true")
+	}
+	if query.prereleaseTag != "" {
+		parameters.Set("preReleaseTag", query.prereleaseTag)
+	}
+
+	var matchingVersions []*packages.PackageVersion
+	for skip := 0; ; {
+		parameters.Set("skip", strconv.Itoa(skip))
+		page, err := newclient.Get[resources.Resources[*packages.PackageVersion]](octopus.HttpSession(), fmt.Sprintf("/api/%s/feeds/%s/packages/versions?%s", query.spaceID, query.feedID, parameters.Encode()))
+		if err != nil {
+			return nil, err
+		}
+
+		matchingVersions = append(matchingVersions, filterFeedPackageVersions(page.Items, query.semVerRange)...)
+		if query.take > 0 && len(matchingVersions) >= query.take {
+			return matchingVersions[:query.take], nil
+		}
+
+		skip += len(page.Items)
+		if len(page.Items) == 0 || skip >= page.TotalResults {
+			return matchingVersions, nil
+		}
+	}
+}
+
+func filterFeedPackageVersions(versions []*packages.PackageVersion, semVerRange *util.SemVerRange) []*packages.PackageVersion {
+	if semVerRange == nil {
+		return versions
+	}
+
+	var matchingVersions []*packages.PackageVersion
+	for _, version := range versions {
+		if semVerRange.Contains(version.Version) {
+			matchingVersions = append(matchingVersions, version)
+		}
+	}
+	return matchingVersions
+}
+
+func flattenFeedPackageVersion(version *packages.PackageVersion) schemas.FeedPackageVersionModel {
+	published := types.StringNull()
+	if !version.Published.IsZero() {
+		published = types.StringValue(version.Published.UTC().Format(time.RFC3339))
+	}
+	sizeBytes := types.Int64Null()
+	if version.SizeBytes > 0 {
+		sizeBytes = types.Int64Value(version.SizeBytes)
+	}
+
+	return schemas.FeedPackageVersionModel{
+		Version:   types.StringValue(version.Version),
+		Published: published,
+		SizeBytes: sizeBytes,
+	}
+}
@@ -0,0 +1,71 @@
+package octopusdeploy_framework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSemVerRange(t *testing.T) {
+	tests := []struct {
+		expression        string
+		includePrerelease bool
+		contains          []string
+		excludes          []string
+	}{
+		{"2.x", false, []string{"2.0.0", "2.15.3", "v2.1"}, []string{"1.9.9", "3.0.0", "2.1.0-rc.1", "latest"}},
+		{"2.x", true, []string{"2.0.0", "2.1.0-rc.1"}, []string{"3.0.0-beta"}},
+		{"^2.1", false, []string{"2.1.0", "2.9.0"}, []string{"2.0.9", "3.0.0"}},
+		{">=1.2 <2.0", false, []string{"1.2.0", "1.99.0"}, []string{"1.1.0", "2.0.0"}},
+		{">=2.0.0-0 <3.0.0", false, []string{"2.1.0-rc.1", "2.1.0"}, []string{"1.9.0", "3.0.0"}},
+	}
+
+	for _, test := range tests {
+		semVerRange, err := util.ParseSemVerRange(test.expression, test.includePrerelease)
+		require.NoError(t, err, test.expression)
+		for _, version := range test.contains {
+			assert.True(t, semVerRange.Contains(version), "%s should contain %s", test.expression, version)
+		}
+		for _, version := range test.excludes {
+			assert.False(t, semVerRange.Contains(version), "%s should not contain %s", test.expression, version)
+		}
+	}
+
+	_, err := util.ParseSemVerRange("not a range", false)
+	assert.Error(t, err)
+}
+
+func TestFilterFeedPackageVersions(t *testing.T) {
+	versions := []*packages.PackageVersion{
+		{Version: "3.0.0"},
+		{Version: "2.4.1"},
+		{Version: "2.4.0"},
+		{Version: "1.9.0"},
+	}
+
+	assert.Equal(t, versions, filterFeedPackageVersions(versions, nil))
+
+	semVerRange, err := util.ParseSemVerRange("2.x", false)
+	require.NoError(t, err)
+	assert.Equal(t, []*packages.PackageVersion{versions[1], versions[2]}, filterFeedPackageVersions(versions, semVerRange))
+}
+
+func TestFlattenFeedPackageVersion(t *testing.T) {
+	model := flattenFeedPackageVersion(&packages.PackageVersion{
+		Version:   "2.4.1",
+		Published: time.Date(2024, 5, 1, 10, 30, 0, 0, time.FixedZone("AEST", 10*60*60)),
+		SizeBytes: 1024,
+	})
+	assert.Equal(t, types.StringValue("2.4.1"), model.Version)
+	assert.Equal(t, types.StringValue("2024-05-01T00:30:00Z"), model.Published)
+	assert.Equal(t, types.Int64Value(1024), model.SizeBytes)
+
+	model = flattenFeedPackageVersion(&packages.PackageVersion{Version: "2.4.1"})
+	assert.True(t, model.Published.IsNull())
+	assert.True(t, model.SizeBytes.IsNull())
+}
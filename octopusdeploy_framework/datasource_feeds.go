@@ -23,8 +23,8 @@ func (*feedsDataSource) Metadata(_ context.Context, req datasource.MetadataReque
 	resp.TypeName = util.GetTypeName("feeds")
 }
 
-func (e *feedsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	e.Config = DataSourceConfiguration(req, resp)
+func (e *feedsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	e.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (*feedsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -55,8 +55,8 @@ func (g *gitCredentialsDataSource) Schema(_ context.Context, _ datasource.Schema
 	resp.Schema = schemas.GitCredentialSchema{}.GetDatasourceSchema()
 }
 
-func (g *gitCredentialsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	g.Config = DataSourceConfiguration(req, resp)
+func (g *gitCredentialsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	g.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (g *gitCredentialsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -0,0 +1,245 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &kubernetesAgentHelmValuesDataSource{}
+
+type kubernetesAgentHelmValuesDataSource struct {
+	*Config
+}
+
+func NewKubernetesAgentHelmValuesDataSource() datasource.DataSource {
+	return &kubernetesAgentHelmValuesDataSource{}
+}
+
+func (k *kubernetesAgentHelmValuesDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.KubernetesAgentHelmValuesDataSourceName)
+}
+
+func (k *kubernetesAgentHelmValuesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.KubernetesAgentHelmValuesSchema{}.GetDatasourceSchema()
+}
+
+func (k *kubernetesAgentHelmValuesDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return schemas.KubernetesAgentHelmValuesSchema{}.GetDatasourceConfigValidators()
+}
+
+func (k *kubernetesAgentHelmValuesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	k.Config = DataSourceConfiguration(ctx, req, resp)
+}
+
+func (k *kubernetesAgentHelmValuesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schemas.KubernetesAgentHelmValuesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.DatasourceReading(ctx, "kubernetes agent helm values", data.Name.ValueString())
+
+	if data.ServerURL.ValueString() == "" {
+		data.ServerURL = types.StringValue(k.Config.Address)
+	}
+
+	if data.ServerCommsAddress.ValueString() == "" {
+		commsAddress, err := getDefaultServerCommsAddress(data.ServerURL.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("unable to determine the server comms address, set server_comms_address", err.Error())
+			return
+		}
+		data.ServerCommsAddress = types.StringValue(commsAddress)
+	}
+
+	if data.SpaceName.ValueString() == "" {
+		spaceName, err := k.Config.GetSpaceName()
+		if err != nil {
+			resp.Diagnostics.AddError("unable to determine the space of the agent, set space_name", err.Error())
+			return
+		}
+		data.SpaceName = types.StringValue(spaceName)
+	}
+
+	if data.ChartVersion.ValueString() == "" {
+		data.ChartVersion = types.StringValue(schemas.KubernetesAgentDefaultChartVersion)
+	}
+	if data.Namespace.ValueString() == "" {
+		data.Namespace = types.StringValue(getKubernetesAgentNamespace(data.Name.ValueString()))
+	}
+	if data.ReleaseName.ValueString() == "" {
+		data.ReleaseName = data.Namespace
+	}
+
+	values := getKubernetesAgentHelmValues(data)
+
+	renderedValues, err := json.MarshalIndent(values.nested(), "", "  ")
+	if err != nil {
+		resp.Diagnostics.AddError("unable to render the helm values", err.Error())
+		return
+	}
+
+	set, setSensitive := values.set()
+
+	data.ID = data.Name
+	data.Chart = types.StringValue(schemas.KubernetesAgentChart)
+	data.Values = types.StringValue(string(renderedValues))
+	data.Set = types.MapValueMust(types.StringType, util.ConvertStringMapToAttrStringMap(set))
+	data.SetSensitive = types.MapValueMust(types.StringType, util.ConvertStringMapToAttrStringMap(setSensitive))
+	data.InstallCommand = types.StringValue(values.installCommand(data))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// helmValue is a Helm chart value, named by its path in the values, e.g. agent.space.
+type helmValue struct {
+	name      string
+	value     any
+	sensitive bool
+}
+
+type helmValues []helmValue
+
+func (h *helmValues) add(name string, value any) {
+	*h = append(*h, helmValue{name: name, value: value})
+}
+
+func (h *helmValues) addSensitive(name string, value any) {
+	*h = append(*h, helmValue{name: name, value: value, sensitive: true})
+}
+
+func (h *helmValues) addOptional(name string, value types.String) {
+	if value.ValueString() != "" {
+		h.add(name, value.ValueString())
+	}
+}
+
+func (h *helmValues) addList(name string, value types.List) {
+	if list := util.ExpandStringList(value); len(list) > 0 {
+		h.add(name, list)
+	}
+}
+
+func getKubernetesAgentHelmValues(data schemas.KubernetesAgentHelmValuesModel) helmValues {
+	var values helmValues
+	values.add("agent.acceptEula", "Y")
+	values.add("agent.name", data.Name.ValueString())
+	values.add("agent.serverUrl", data.ServerURL.ValueString())
+	values.add("agent.serverCommsAddresses", []string{data.ServerCommsAddress.ValueString()})
+	values.add("agent.space", data.SpaceName.ValueString())
+	values.addOptional("agent.machinePolicyName", data.MachinePolicyName)
+
+	if data.Certificate.ValueString() != "" {
+		values.addSensitive("agent.certificate", data.Certificate.ValueString())
+		values.add("agent.serverSubscriptionId", data.SubscriptionURI.ValueString())
+	} else {
+		values.addSensitive("agent.bearerToken", data.BearerToken.ValueString())
+	}
+
+	if len(util.ExpandStringList(data.WorkerPools)) > 0 {
+		values.add("agent.worker.enabled", true)
+		values.addList("agent.worker.initial.workerPools", data.WorkerPools)
+	} else {
+		values.add("agent.deploymentTarget.enabled", true)
+		values.addList("agent.deploymentTarget.initial.environments", data.Environments)
+		values.addList("agent.deploymentTarget.initial.tags", data.TargetTags)
+		values.addList("agent.deploymentTarget.initial.tenants", data.Tenants)
+		values.addList("agent.deploymentTarget.initial.tenantTags", data.TenantTags)
+		values.addOptional("agent.deploymentTarget.initial.tenantedDeploymentParticipation", data.TenantedDeploymentParticipation)
+		values.addOptional("agent.deploymentTarget.initial.defaultNamespace", data.DefaultNamespace)
+	}
+
+	values.addOptional("persistence.storageClassName", data.StorageClassName)
+
+	return values
+}
+
+// nested returns the values as they are written in a values file.
+func (h helmValues) nested() map[string]any {
+	nested := map[string]any{}
+	for _, value := range h {
+		parts := strings.Split(value.name, ".")
+		parent := nested
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := parent[part].(map[string]any)
+			if !ok {
+				child = map[string]any{}
+				parent[part] = child
+			}
+			parent = child
+		}
+		parent[parts[len(parts)-1]] = value.value
+	}
+	return nested
+}
+
+// set returns the values as they are passed to Helm with --set and --set-sensitive.
+func (h helmValues) set() (map[string]string, map[string]string) {
+	set := map[string]string{}
+	setSensitive := map[string]string{}
+	for _, value := range h {
+		if value.sensitive {
+			setSensitive[value.name] = formatHelmSetValue(value.value)
+		} else {
+			set[value.name] = formatHelmSetValue(value.value)
+		}
+	}
+	return set, setSensitive
+}
+
+func (h helmValues) installCommand(data schemas.KubernetesAgentHelmValuesModel) string {
+	lines := []string{"helm upgrade --install --atomic"}
+	for _, value := range h {
+		lines = append(lines, fmt.Sprintf("--set %s=%q", value.name, formatHelmSetValue(value.value)))
+	}
+	lines = append(lines,
+		fmt.Sprintf("--version %q", data.ChartVersion.ValueString()),
+		fmt.Sprintf("--create-namespace --namespace %s", data.Namespace.ValueString()),
+		fmt.Sprintf("%s %s", data.ReleaseName.ValueString(), schemas.KubernetesAgentChart),
+	)
+	return strings.Join(lines, " \\\n")
+}
+
+func formatHelmSetValue(value any) string {
+	switch v := value.(type) {
+	case []string:
+		return "{" + strings.Join(v, ",") + "}"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// getDefaultServerCommsAddress returns the address agents poll the server on, port 10943 of the server host.
+func getDefaultServerCommsAddress(serverURL string) (string, error) {
+	parsed, err := url.Parse(serverURL)
+	if err != nil {
+		return "", err
+	}
+	if parsed.Hostname() == "" {
+		return "", fmt.Errorf("the server URL '%s' has no host", serverURL)
+	}
+	return fmt.Sprintf("https://%s/", net.JoinHostPort(parsed.Hostname(), "10943")), nil
+}
+
+var invalidNamespaceCharacters = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// getKubernetesAgentNamespace returns a valid Kubernetes namespace for the agent, as the Octopus UI does.
+func getKubernetesAgentNamespace(name string) string {
+	namespace := "octopus-agent-" + strings.Trim(invalidNamespaceCharacters.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(namespace) > 63 {
+		namespace = namespace[:63]
+	}
+	// A namespace must end with a letter or digit, which a truncated name or a name without any may not give it
+	return strings.TrimRight(namespace, "-")
+}
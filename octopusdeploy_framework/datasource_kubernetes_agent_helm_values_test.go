@@ -0,0 +1,78 @@
+package octopusdeploy_framework
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKubernetesAgentHelmValuesForDeploymentTarget(t *testing.T) {
+	data := schemas.KubernetesAgentHelmValuesModel{
+		Name:               types.StringValue("Production Cluster"),
+		ServerURL:          types.StringValue("https://octopus.example.com"),
+		ServerCommsAddress: types.StringValue("https://octopus.example.com:10943/"),
+		SpaceName:          types.StringValue("Default"),
+		BearerToken:        types.StringValue("token"),
+		Environments:       util.FlattenStringList([]string{"production"}),
+		TargetTags:         util.FlattenStringList([]string{"k8s", "web"}),
+		WorkerPools:        types.ListNull(types.StringType),
+		StorageClassName:   types.StringValue("azurefile"),
+	}
+
+	values := getKubernetesAgentHelmValues(data)
+	set, setSensitive := values.set()
+
+	assert.Equal(t, "{production}", set["agent.deploymentTarget.initial.environments"])
+	assert.Equal(t, "{k8s,web}", set["agent.deploymentTarget.initial.tags"])
+	assert.Equal(t, "{https://octopus.example.com:10943/}", set["agent.serverCommsAddresses"])
+	assert.Equal(t, "azurefile", set["persistence.storageClassName"])
+	assert.NotContains(t, set, "agent.bearerToken")
+	assert.Equal(t, map[string]string{"agent.bearerToken": "token"}, setSensitive)
+
+	nested := values.nested()
+	agent := nested["agent"].(map[string]any)
+	assert.Equal(t, "Default", agent["space"])
+	assert.Equal(t, true, agent["deploymentTarget"].(map[string]any)["enabled"])
+	assert.NotContains(t, agent, "worker")
+}
+
+func TestKubernetesAgentHelmValuesForRegisteredWorker(t *testing.T) {
+	data := schemas.KubernetesAgentHelmValuesModel{
+		Name:            types.StringValue("worker"),
+		ServerURL:       types.StringValue("https://octopus.example.com"),
+		SpaceName:       types.StringValue("Default"),
+		Certificate:     types.StringValue("MIIJ..."),
+		SubscriptionURI: types.StringValue("poll://abcdefghijklmnopqrst/"),
+		WorkerPools:     util.FlattenStringList([]string{"kubernetes-workers"}),
+	}
+
+	set, setSensitive := getKubernetesAgentHelmValues(data).set()
+
+	assert.Equal(t, "true", set["agent.worker.enabled"])
+	assert.Equal(t, "{kubernetes-workers}", set["agent.worker.initial.workerPools"])
+	assert.Equal(t, "poll://abcdefghijklmnopqrst/", set["agent.serverSubscriptionId"])
+	assert.NotContains(t, set, "agent.deploymentTarget.enabled")
+	assert.Equal(t, map[string]string{"agent.certificate": "MIIJ..."}, setSensitive)
+}
+
+func TestGetDefaultServerCommsAddress(t *testing.T) {
+	address, err := getDefaultServerCommsAddress("https://octopus.example.com:8080/path")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://octopus.example.com:10943/", address)
+
+	_, err = getDefaultServerCommsAddress("not a url")
+	assert.Error(t, err)
+}
+
+func TestGetKubernetesAgentNamespace(t *testing.T) {
+	assert.Equal(t, "octopus-agent-production-cluster", getKubernetesAgentNamespace("Production Cluster!"))
+	assert.Equal(t, "octopus-agent-team-a", getKubernetesAgentNamespace("--Team A--"))
+	assert.Equal(t, "octopus-agent", getKubernetesAgentNamespace("!!!"))
+	assert.Equal(t, "octopus-agent", getKubernetesAgentNamespace(string(make([]byte, 100))))
+	assert.Equal(t, "octopus-agent-"+strings.Repeat("a", 49), getKubernetesAgentNamespace(strings.Repeat("a", 100)))
+	assert.Equal(t, "octopus-agent-"+strings.Repeat("a", 48), getKubernetesAgentNamespace(strings.Repeat("a", 48)+" b"))
+}
@@ -47,7 +47,7 @@ func (l *lifecyclesDataSource) Schema(ctx context.Context, req datasource.Schema
 
 func (l *lifecyclesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	tflog.Debug(ctx, "lifecycles datasource Configure")
-	l.Config = DataSourceConfiguration(req, resp)
+	l.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (l *lifecyclesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
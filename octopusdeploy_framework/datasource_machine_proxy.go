@@ -29,8 +29,8 @@ func (p *machineProxyDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 	resp.Schema = schemas.MachineProxySchema{}.GetDatasourceSchema()
 }
 
-func (p *machineProxyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	p.Config = DataSourceConfiguration(req, resp)
+func (p *machineProxyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	p.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (p *machineProxyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
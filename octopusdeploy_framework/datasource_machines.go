@@ -0,0 +1,383 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/workers"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &machinesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &machinesDataSource{}
+
+type machinesDataSource struct {
+	*Config
+}
+
+func NewMachinesDataSource() datasource.DataSource {
+	return &machinesDataSource{}
+}
+
+func (m *machinesDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.MachinesDataSourceName)
+}
+
+func (m *machinesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.MachinesSchema{}.GetDatasourceSchema()
+}
+
+func (m *machinesDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return schemas.MachinesSchema{}.GetDatasourceConfigValidators()
+}
+
+func (m *machinesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data schemas.MachinesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateMachineKindFilters(data)...)
+}
+
+// validateMachineKindFilters rejects the filters that only apply to the kind of machine that kind excludes, as they
+// would always return no machines.
+func validateMachineKindFilters(data schemas.MachinesDataSourceModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if data.Kind.IsNull() || data.Kind.IsUnknown() {
+		return diags
+	}
+
+	filters := []struct {
+		kind      string
+		attribute string
+		values    types.List
+	}{
+		{schemas.MachineKindWorker, "roles", data.Roles},
+		{schemas.MachineKindWorker, "environment_ids", data.EnvironmentIDs},
+		{schemas.MachineKindWorker, "tenant_ids", data.TenantIDs},
+		{schemas.MachineKindWorker, "tenant_tags", data.TenantTags},
+		{schemas.MachineKindDeploymentTarget, "worker_pool_ids", data.WorkerPoolIDs},
+	}
+	for _, filter := range filters {
+		if filter.kind == data.Kind.ValueString() && len(filter.values.Elements()) > 0 {
+			diags.AddAttributeError(
+				path.Root(filter.attribute),
+				"Invalid machines filter",
+				fmt.Sprintf("%s doesn't apply to machines of kind %s, so no machines would be returned.", filter.attribute, data.Kind.ValueString()),
+			)
+		}
+	}
+	return diags
+}
+
+func (m *machinesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	m.Config = DataSourceConfiguration(ctx, req, resp)
+}
+
+func (m *machinesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schemas.MachinesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := data.SpaceID.ValueString()
+	includeTargets, includeWorkers := getMachineKinds(data)
+
+	var results []machineResult
+
+	if includeTargets {
+		query := machines.MachinesQuery{
+			IDs:                 util.GetIds(data.IDs),
+			Name:                data.Name.ValueString(),
+			PartialName:         data.PartialName.ValueString(),
+			Roles:               util.ExpandStringList(data.Roles),
+			EnvironmentIDs:      util.ExpandStringList(data.EnvironmentIDs),
+			TenantIDs:           util.ExpandStringList(data.TenantIDs),
+			TenantTags:          util.ExpandStringList(data.TenantTags),
+			HealthStatuses:      util.ExpandStringList(data.HealthStatuses),
+			CommunicationStyles: util.ExpandStringList(data.CommunicationStyles),
+		}
+
+		util.DatasourceReading(ctx, "deployment targets", query)
+
+		page, err := machines.Get(m.Client, spaceID, query)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to load deployment targets", err.Error())
+			return
+		}
+		deploymentTargets, err := page.GetAllPages(m.Client.Sling())
+		if err != nil {
+			resp.Diagnostics.AddError("unable to load deployment targets", err.Error())
+			return
+		}
+		for _, deploymentTarget := range deploymentTargets {
+			results = append(results, flattenDeploymentTargetMachine(deploymentTarget))
+		}
+	}
+
+	if includeWorkers {
+		query := machines.WorkersQuery{
+			IDs:                 util.GetIds(data.IDs),
+			Name:                data.Name.ValueString(),
+			PartialName:         data.PartialName.ValueString(),
+			WorkerPoolIDs:       util.ExpandStringList(data.WorkerPoolIDs),
+			HealthStatuses:      util.ExpandStringList(data.HealthStatuses),
+			CommunicationStyles: util.ExpandStringList(data.CommunicationStyles),
+		}
+
+		util.DatasourceReading(ctx, "workers", query)
+
+		page, err := workers.Get(m.Client, spaceID, query)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to load workers", err.Error())
+			return
+		}
+		existingWorkers, err := page.GetAllPages(m.Client.Sling())
+		if err != nil {
+			resp.Diagnostics.AddError("unable to load workers", err.Error())
+			return
+		}
+		for _, worker := range existingWorkers {
+			results = append(results, flattenWorkerMachine(worker))
+		}
+	}
+
+	results = filterMachines(results, data)
+
+	if data.IncludeLastHealthCheck.ValueBool() {
+		for i := range results {
+			lastHealthCheck, err := getLastHealthCheck(m.Client, results[i].tasksLink)
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("unable to load the health checks of %s", results[i].ID.ValueString()), err.Error())
+				return
+			}
+			results[i].LastHealthCheck = lastHealthCheck
+		}
+	}
+
+	util.DatasourceResultCount(ctx, "machines", len(results))
+
+	machineModels := make([]schemas.MachineModel, len(results))
+	for i, result := range results {
+		machineModels[i] = result.MachineModel
+	}
+
+	machineList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: schemas.MachineObjectType()}, machineModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Machines = machineList
+	data.ID = types.StringValue("Machines " + time.Now().UTC().String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getMachineKinds returns whether deployment targets and workers are queried. Filters that only apply to one kind
+// of machine exclude the other.
+func getMachineKinds(data schemas.MachinesDataSourceModel) (bool, bool) {
+	kind := data.Kind.ValueString()
+	hasTargetFilters := len(util.ExpandStringList(data.Roles)) > 0 ||
+		len(util.ExpandStringList(data.EnvironmentIDs)) > 0 ||
+		len(util.ExpandStringList(data.TenantIDs)) > 0 ||
+		len(util.ExpandStringList(data.TenantTags)) > 0
+	hasWorkerFilters := len(util.ExpandStringList(data.WorkerPoolIDs)) > 0
+
+	includeTargets := kind != schemas.MachineKindWorker && !hasWorkerFilters
+	includeWorkers := kind != schemas.MachineKindDeploymentTarget && !hasTargetFilters
+	return includeTargets, includeWorkers
+}
+
+// machineResult is a machine in the results, with the link to its tasks.
+type machineResult struct {
+	schemas.MachineModel
+	tasksLink string
+}
+
+// filterMachines applies the filters the Octopus API has no query parameter for, then skip and take.
+func filterMachines(results []machineResult, data schemas.MachinesDataSourceModel) []machineResult {
+	filtered := make([]machineResult, 0, len(results))
+	for _, result := range results {
+		if !data.IsDisabled.IsNull() && result.IsDisabled.ValueBool() != data.IsDisabled.ValueBool() {
+			continue
+		}
+		filtered = append(filtered, result)
+	}
+
+	skip := min(util.GetNumber(data.Skip), len(filtered))
+	filtered = filtered[skip:]
+	if take := util.GetNumber(data.Take); take > 0 && take < len(filtered) {
+		filtered = filtered[:take]
+	}
+	return filtered
+}
+
+func flattenDeploymentTargetMachine(deploymentTarget *machines.DeploymentTarget) machineResult {
+	result := machineResult{
+		MachineModel: schemas.MachineModel{
+			ID:                              types.StringValue(deploymentTarget.GetID()),
+			SpaceID:                         types.StringValue(deploymentTarget.SpaceID),
+			Kind:                            types.StringValue(schemas.MachineKindDeploymentTarget),
+			Name:                            types.StringValue(deploymentTarget.Name),
+			IsDisabled:                      types.BoolValue(deploymentTarget.IsDisabled),
+			MachinePolicyID:                 types.StringValue(deploymentTarget.MachinePolicyID),
+			HealthStatus:                    types.StringValue(deploymentTarget.HealthStatus),
+			StatusSummary:                   types.StringValue(deploymentTarget.StatusSummary),
+			HasLatestCalamari:               types.BoolValue(deploymentTarget.HasLatestCalamari),
+			OperatingSystem:                 util.StringOrNull(deploymentTarget.OperatingSystem),
+			ShellName:                       util.StringOrNull(deploymentTarget.ShellName),
+			Roles:                           util.FlattenStringList(deploymentTarget.Roles),
+			EnvironmentIDs:                  util.FlattenStringList(deploymentTarget.EnvironmentIDs),
+			TenantIDs:                       util.FlattenStringList(deploymentTarget.TenantIDs),
+			TenantTags:                      util.FlattenStringList(deploymentTarget.TenantTags),
+			TenantedDeploymentParticipation: types.StringValue(string(deploymentTarget.TenantedDeploymentMode)),
+			WorkerPoolIDs:                   types.ListNull(types.StringType),
+		},
+		tasksLink: deploymentTarget.GetLinks()["TasksTemplate"],
+	}
+	flattenMachineEndpoint(&result.MachineModel, deploymentTarget.Endpoint)
+	return result
+}
+
+func flattenWorkerMachine(worker *machines.Worker) machineResult {
+	result := machineResult{
+		MachineModel: schemas.MachineModel{
+			ID:                              types.StringValue(worker.GetID()),
+			SpaceID:                         types.StringValue(worker.SpaceID),
+			Kind:                            types.StringValue(schemas.MachineKindWorker),
+			Name:                            types.StringValue(worker.Name),
+			IsDisabled:                      types.BoolValue(worker.IsDisabled),
+			MachinePolicyID:                 types.StringValue(worker.MachinePolicyID),
+			HealthStatus:                    types.StringValue(worker.HealthStatus),
+			StatusSummary:                   types.StringValue(worker.StatusSummary),
+			HasLatestCalamari:               types.BoolValue(worker.HasLatestCalamari),
+			OperatingSystem:                 util.StringOrNull(worker.OperatingSystem),
+			ShellName:                       util.StringOrNull(worker.ShellName),
+			Roles:                           types.ListNull(types.StringType),
+			EnvironmentIDs:                  types.ListNull(types.StringType),
+			TenantIDs:                       types.ListNull(types.StringType),
+			TenantTags:                      types.ListNull(types.StringType),
+			TenantedDeploymentParticipation: types.StringNull(),
+			WorkerPoolIDs:                   util.FlattenStringList(worker.WorkerPoolIDs),
+		},
+		tasksLink: worker.GetLinks()["TasksTemplate"],
+	}
+	flattenMachineEndpoint(&result.MachineModel, worker.Endpoint)
+	return result
+}
+
+// flattenMachineEndpoint sets the endpoint details, which depend on the communication style of the machine.
+func flattenMachineEndpoint(model *schemas.MachineModel, endpoint machines.IEndpoint) {
+	model.URI = types.StringNull()
+	model.Thumbprint = types.StringNull()
+	model.ProxyID = types.StringNull()
+	model.Host = types.StringNull()
+	model.Port = types.Int64Null()
+	model.ClusterURL = types.StringNull()
+	model.TentacleVersion = types.StringNull()
+	model.TentacleUpgradeSuggested = types.BoolNull()
+	model.LastHealthCheck = types.StringNull()
+	model.CommunicationStyle = types.StringNull()
+
+	if endpoint == nil {
+		return
+	}
+	model.CommunicationStyle = types.StringValue(endpoint.GetCommunicationStyle())
+
+	flattenTentacleVersion := func(details *machines.TentacleVersionDetails) {
+		if details != nil {
+			model.TentacleVersion = util.StringOrNull(details.Version)
+			model.TentacleUpgradeSuggested = types.BoolValue(details.UpgradeSuggested || details.UpgradeRequired)
+		}
+	}
+
+	switch e := endpoint.(type) {
+	case *machines.ListeningTentacleEndpoint:
+		model.Thumbprint = util.StringOrNull(e.Thumbprint)
+		model.ProxyID = util.StringOrNull(e.ProxyID)
+		if e.URI != nil {
+			model.URI = types.StringValue(e.URI.String())
+		}
+		flattenTentacleVersion(e.TentacleVersionDetails)
+	case *machines.PollingTentacleEndpoint:
+		model.Thumbprint = util.StringOrNull(e.Thumbprint)
+		if e.URI != nil {
+			model.URI = types.StringValue(e.URI.String())
+		}
+		flattenTentacleVersion(e.TentacleVersionDetails)
+	case *machines.KubernetesTentacleEndpoint:
+		if configuration := e.TentacleEndpointConfiguration; configuration != nil {
+			model.Thumbprint = util.StringOrNull(configuration.Thumbprint)
+			if configuration.URI != nil {
+				model.URI = types.StringValue(configuration.URI.String())
+			}
+		}
+		if details := e.KubernetesAgentDetails; details != nil {
+			model.TentacleVersion = util.StringOrNull(details.AgentVersion)
+			model.TentacleUpgradeSuggested = types.BoolValue(details.UpgradeStatus == "UpgradeSuggested" || details.UpgradeStatus == "UpgradeRequired")
+		}
+	case *machines.SSHEndpoint:
+		model.Host = util.StringOrNull(e.Host)
+		model.Port = types.Int64Value(int64(e.Port))
+		model.ProxyID = util.StringOrNull(e.ProxyID)
+		if e.URI != nil {
+			model.URI = types.StringValue(e.URI.String())
+		}
+	case *machines.KubernetesEndpoint:
+		model.ProxyID = util.StringOrNull(e.ProxyID)
+		if e.ClusterURL != nil {
+			model.ClusterURL = types.StringValue(e.ClusterURL.String())
+		}
+	}
+}
+
+// healthCheckTaskName is the name of the server task that checks the health of machines.
+const healthCheckTaskName = "Health"
+
+// getLastHealthCheck returns the completion time of the most recent health check in the tasks of a machine.
+func getLastHealthCheck(octopus *client.Client, tasksLink string) (types.String, error) {
+	if tasksLink == "" {
+		return types.StringNull(), nil
+	}
+
+	// The link is a URI template, e.g. /api/Spaces-1/machines/Machines-1/tasks{?skip,take,type}
+	tasksPath := strings.SplitN(tasksLink, "{", 2)[0] + "?take=100"
+	machineTasks, err := newclient.Get[resources.Resources[*tasks.Task]](octopus.HttpSession(), tasksPath)
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	return getLatestHealthCheckTime(machineTasks.Items), nil
+}
+
+func getLatestHealthCheckTime(machineTasks []*tasks.Task) types.String {
+	var latest *time.Time
+	for _, task := range machineTasks {
+		if task.Name != healthCheckTaskName || task.CompletedTime == nil {
+			continue
+		}
+		if latest == nil || task.CompletedTime.After(*latest) {
+			latest = task.CompletedTime
+		}
+	}
+	if latest == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(latest.UTC().Format(time.RFC3339))
+}
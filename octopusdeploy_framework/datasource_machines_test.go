@@ -0,0 +1,126 @@
+package octopusdeploy_framework
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMachineKinds(t *testing.T) {
+	emptyList := types.ListNull(types.StringType)
+	data := schemas.MachinesDataSourceModel{
+		Roles:          emptyList,
+		EnvironmentIDs: emptyList,
+		TenantIDs:      emptyList,
+		TenantTags:     emptyList,
+		WorkerPoolIDs:  emptyList,
+	}
+
+	includeTargets, includeWorkers := getMachineKinds(data)
+	assert.True(t, includeTargets)
+	assert.True(t, includeWorkers)
+
+	data.Kind = types.StringValue(schemas.MachineKindWorker)
+	includeTargets, includeWorkers = getMachineKinds(data)
+	assert.False(t, includeTargets)
+	assert.True(t, includeWorkers)
+
+	data.Kind = types.StringNull()
+	data.Roles = util.FlattenStringList([]string{"web"})
+	includeTargets, includeWorkers = getMachineKinds(data)
+	assert.True(t, includeTargets)
+	assert.False(t, includeWorkers)
+}
+
+func TestValidateMachineKindFilters(t *testing.T) {
+	emptyList := types.ListNull(types.StringType)
+	data := schemas.MachinesDataSourceModel{
+		Kind:           types.StringValue(schemas.MachineKindWorker),
+		Roles:          util.FlattenStringList([]string{"web"}),
+		EnvironmentIDs: emptyList,
+		TenantIDs:      emptyList,
+		TenantTags:     util.FlattenStringList([]string{"Region/Europe"}),
+		WorkerPoolIDs:  util.FlattenStringList([]string{"WorkerPools-1"}),
+	}
+
+	diags := validateMachineKindFilters(data)
+	require.Len(t, diags, 2)
+	assert.Equal(t, path.Root("roles"), diags[0].(diag.DiagnosticWithPath).Path())
+	assert.Equal(t, path.Root("tenant_tags"), diags[1].(diag.DiagnosticWithPath).Path())
+
+	data.Kind = types.StringValue(schemas.MachineKindDeploymentTarget)
+	diags = validateMachineKindFilters(data)
+	require.Len(t, diags, 1)
+	assert.Equal(t, path.Root("worker_pool_ids"), diags[0].(diag.DiagnosticWithPath).Path())
+
+	data.Kind = types.StringNull()
+	assert.Empty(t, validateMachineKindFilters(data))
+}
+
+func TestFilterMachines(t *testing.T) {
+	results := []machineResult{
+		{MachineModel: schemas.MachineModel{ID: types.StringValue("Machines-1"), IsDisabled: types.BoolValue(false)}},
+		{MachineModel: schemas.MachineModel{ID: types.StringValue("Machines-2"), IsDisabled: types.BoolValue(true)}},
+		{MachineModel: schemas.MachineModel{ID: types.StringValue("Workers-1"), IsDisabled: types.BoolValue(false)}},
+	}
+
+	filtered := filterMachines(results, schemas.MachinesDataSourceModel{IsDisabled: types.BoolValue(false)})
+	assert.Len(t, filtered, 2)
+	assert.Equal(t, "Workers-1", filtered[1].ID.ValueString())
+
+	filtered = filterMachines(results, schemas.MachinesDataSourceModel{IsDisabled: types.BoolNull(), Skip: types.Int64Value(1), Take: types.Int64Value(1)})
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "Machines-2", filtered[0].ID.ValueString())
+
+	filtered = filterMachines(results, schemas.MachinesDataSourceModel{IsDisabled: types.BoolNull(), Skip: types.Int64Value(5)})
+	assert.Empty(t, filtered)
+}
+
+func TestFlattenMachineEndpoint(t *testing.T) {
+	uri, _ := url.Parse("https://tentacle.example.com:10933/")
+	endpoint := machines.NewListeningTentacleEndpoint(uri, "THUMBPRINT")
+	endpoint.TentacleVersionDetails = machines.NewTentacleVersionDetails("8.1.0", false, true, false)
+
+	var model schemas.MachineModel
+	flattenMachineEndpoint(&model, endpoint)
+	assert.Equal(t, "TentaclePassive", model.CommunicationStyle.ValueString())
+	assert.Equal(t, "https://tentacle.example.com:10933/", model.URI.ValueString())
+	assert.Equal(t, "THUMBPRINT", model.Thumbprint.ValueString())
+	assert.Equal(t, "8.1.0", model.TentacleVersion.ValueString())
+	assert.True(t, model.TentacleUpgradeSuggested.ValueBool())
+	assert.True(t, model.Host.IsNull())
+
+	sshEndpoint := machines.NewSSHEndpoint("ssh.example.com", 22, "FINGERPRINT")
+	flattenMachineEndpoint(&model, sshEndpoint)
+	assert.Equal(t, "Ssh", model.CommunicationStyle.ValueString())
+	assert.Equal(t, "ssh.example.com", model.Host.ValueString())
+	assert.Equal(t, int64(22), model.Port.ValueInt64())
+	assert.True(t, model.Thumbprint.IsNull())
+	assert.True(t, model.TentacleVersion.IsNull())
+}
+
+func TestGetLatestHealthCheckTime(t *testing.T) {
+	earlier := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	later := time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC)
+	latest := time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC)
+
+	machineTasks := []*tasks.Task{
+		{Name: healthCheckTaskName, CompletedTime: &earlier},
+		{Name: "Deploy", CompletedTime: &latest},
+		{Name: healthCheckTaskName, CompletedTime: &later},
+		{Name: healthCheckTaskName},
+	}
+
+	assert.Equal(t, "2024-05-02T10:00:00Z", getLatestHealthCheckTime(machineTasks).ValueString())
+	assert.True(t, getLatestHealthCheckTime(nil).IsNull())
+}
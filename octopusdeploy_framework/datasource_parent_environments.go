@@ -41,8 +41,8 @@ func (*parentEnvironmentDataSource) Schema(_ context.Context, req datasource.Sch
 	resp.Schema = schemas.ParentEnvironmentSchema{}.GetDatasourceSchema()
 }
 
-func (e *parentEnvironmentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	e.Config = DataSourceConfiguration(req, resp)
+func (e *parentEnvironmentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	e.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (e *parentEnvironmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -42,8 +42,8 @@ func (p *projectsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	resp.Schema = schemas.ProjectSchema{}.GetDatasourceSchema()
 }
 
-func (p *projectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	p.Config = DataSourceConfiguration(req, resp)
+func (p *projectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	p.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (p *projectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
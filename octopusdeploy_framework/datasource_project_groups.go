@@ -48,8 +48,8 @@ func (p *projectGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 	resp.Schema = schemas.ProjectGroupSchema{}.GetDatasourceSchema()
 }
 
-func (p *projectGroupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	p.Config = DataSourceConfiguration(req, resp)
+func (p *projectGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	p.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (p *projectGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
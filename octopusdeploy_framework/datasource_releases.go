@@ -0,0 +1,141 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type releasesDataSource struct {
+	*Config
+}
+
+func NewReleasesDataSource() datasource.DataSource {
+	return &releasesDataSource{}
+}
+
+func (d *releasesDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.ReleasesDataSourceName)
+}
+
+func (d *releasesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.ReleasesSchema{}.GetDatasourceSchema()
+}
+
+func (d *releasesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = DataSourceConfiguration(ctx, req, resp)
+}
+
+func (d *releasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schemas.ReleasesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var versionRange *util.VersionRange
+	if !data.VersionRange.IsNull() {
+		var err error
+		versionRange, err = util.ParseNuGetVersionRange(data.VersionRange.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("version_range"), "Invalid version range", err.Error())
+			return
+		}
+	}
+
+	spaceID := data.SpaceID.ValueString()
+	if spaceID == "" {
+		spaceID = d.Client.GetSpaceID()
+	}
+
+	util.DatasourceReading(ctx, "releases", data.ProjectID.ValueString())
+
+	matchingReleases, err := getReleases(d.Client, spaceID, data.ProjectID.ValueString(), data.ChannelID.ValueString(), data.SearchByVersion.ValueString(), func(release *releases.Release) bool {
+		return versionRange == nil || versionRange.Contains(release.Version)
+	}, data.Latest.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to load releases", err.Error())
+		return
+	}
+
+	util.DatasourceResultCount(ctx, "releases", len(matchingReleases))
+
+	releaseModels := make([]schemas.ReleaseModel, 0, len(matchingReleases))
+	for _, release := range matchingReleases {
+		releaseModels = append(releaseModels, flattenRelease(release))
+	}
+
+	releaseList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: schemas.ReleaseObjectType()}, releaseModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SpaceID = types.StringValue(spaceID)
+	data.Releases = releaseList
+	data.ID = types.StringValue("Releases " + time.Now().UTC().String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getReleases loads the releases of a project or of a channel of the project that match a filter, most recent first.
+// Only the first match is loaded when latestOnly is set.
+func getReleases(octopus *client.Client, spaceID string, projectID string, channelID string, searchByVersion string, matches func(*releases.Release) bool, latestOnly bool) ([]*releases.Release, error) {
+	releasesPath := fmt.Sprintf("/api/%s/projects/%s/releases", spaceID, projectID)
+	if channelID != "" {
+		releasesPath = fmt.Sprintf("/api/%s/channels/%s/releases", spaceID, channelID)
+	}
+	parameters := url.Values{"take": {"100"}}
+	if searchByVersion != "" {
+		parameters.Set("searchByVersion", searchByVersion)
+	}
+	releasesPath += "?" + parameters.Encode()
+
+	var matchingReleases []*releases.Release
+	for releasesPath != "" {
+		page, err := newclient.Get[resources.Resources[*releases.Release]](octopus.HttpSession(), releasesPath)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, release := range page.Items {
+			// The releases of a channel are filtered by project in case the channel is of another project
+			if release.ProjectID != projectID || !matches(release) {
+				continue
+			}
+			matchingReleases = append(matchingReleases, release)
+			if latestOnly {
+				return matchingReleases, nil
+			}
+		}
+		releasesPath = page.Links.PageNext
+	}
+	return matchingReleases, nil
+}
+
+func flattenRelease(release *releases.Release) schemas.ReleaseModel {
+	assembled := types.StringNull()
+	if !release.Assembled.IsZero() {
+		assembled = types.StringValue(release.Assembled.UTC().Format(time.RFC3339))
+	}
+
+	return schemas.ReleaseModel{
+		ID:           types.StringValue(release.GetID()),
+		Version:      types.StringValue(release.Version),
+		ProjectID:    types.StringValue(release.ProjectID),
+		ChannelID:    types.StringValue(release.ChannelID),
+		Assembled:    assembled,
+		ReleaseNotes: types.StringValue(release.ReleaseNotes),
+	}
+}
@@ -0,0 +1,67 @@
+package octopusdeploy_framework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNuGetVersionRange(t *testing.T) {
+	tests := []struct {
+		expression string
+		contains   []string
+		excludes   []string
+	}{
+		{"1.2", []string{"1.2", "1.2.0", "1.3.0", "10.0.0"}, []string{"1.1.9", "1.2.0-beta"}},
+		{"[1.2]", []string{"1.2", "1.2.0"}, []string{"1.2.1", "1.1"}},
+		{"[1.0,2.0)", []string{"1.0", "1.5.3", "1.9.9"}, []string{"0.9", "2.0", "2.0.1"}},
+		{"(1.0,2.0]", []string{"1.0.1", "2.0"}, []string{"1.0", "2.0.1"}},
+		{"(,2.0]", []string{"0.1", "2.0"}, []string{"2.1"}},
+		{"[1.0, )", []string{"1.0", "99.0"}, []string{"0.9"}},
+	}
+
+	for _, test := range tests {
+		versionRange, err := util.ParseNuGetVersionRange(test.expression)
+		require.NoError(t, err, test.expression)
+		for _, version := range test.contains {
+			assert.True(t, versionRange.Contains(version), "%s should contain %s", test.expression, version)
+		}
+		for _, version := range test.excludes {
+			assert.False(t, versionRange.Contains(version), "%s should not contain %s", test.expression, version)
+		}
+	}
+
+	versionRange, err := util.ParseNuGetVersionRange("[1.0,2.0)")
+	require.NoError(t, err)
+	assert.False(t, versionRange.Contains("not a version"))
+}
+
+func TestNuGetVersionRangeInvalid(t *testing.T) {
+	for _, expression := range []string{"", "[1.0", "(1.0)", "[,]", "[1.0,2.0,3.0]", "[one,two]"} {
+		_, err := util.ParseNuGetVersionRange(expression)
+		assert.Error(t, err, expression)
+	}
+}
+
+func TestFlattenRelease(t *testing.T) {
+	release := releases.NewRelease("Channels-1", "Projects-1", "1.2.3")
+	release.ID = "Releases-1"
+	release.ReleaseNotes = "Fixes"
+	release.Assembled = time.Date(2024, 5, 1, 10, 30, 0, 0, time.FixedZone("AEST", 10*60*60))
+
+	model := flattenRelease(release)
+
+	assert.Equal(t, types.StringValue("Releases-1"), model.ID)
+	assert.Equal(t, types.StringValue("1.2.3"), model.Version)
+	assert.Equal(t, types.StringValue("Channels-1"), model.ChannelID)
+	assert.Equal(t, types.StringValue("Projects-1"), model.ProjectID)
+	assert.Equal(t, types.StringValue("2024-05-01T00:30:00Z"), model.Assembled)
+	assert.Equal(t, types.StringValue("Fixes"), model.ReleaseNotes)
+
+	assert.True(t, flattenRelease(releases.NewRelease("Channels-1", "Projects-1", "1.2.3")).Assembled.IsNull())
+}
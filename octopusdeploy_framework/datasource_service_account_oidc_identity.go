@@ -21,8 +21,8 @@ func (*serviceAccountOIDCIdentityDataSource) Metadata(_ context.Context, _ datas
 	resp.TypeName = util.GetTypeName(schemas.ServiceAccountOIDCIdentityDatasourceName)
 }
 
-func (s *serviceAccountOIDCIdentityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	s.Config = DataSourceConfiguration(req, resp)
+func (s *serviceAccountOIDCIdentityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	s.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (*serviceAccountOIDCIdentityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
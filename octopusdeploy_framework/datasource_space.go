@@ -29,8 +29,8 @@ func (*spaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, re
 	resp.Schema = schemas.SpaceSchema{}.GetDatasourceSchema()
 }
 
-func (b *spaceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	b.Config = DataSourceConfiguration(req, resp)
+func (b *spaceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	b.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (b *spaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -23,8 +23,8 @@ func (s *spaceDefaultLifecycleReleaseRetentionPoliciesDataSource) Metadata(_ con
 	resp.TypeName = util.GetTypeName("space_default_lifecycle_release_retention_policy")
 }
 
-func (s *spaceDefaultLifecycleReleaseRetentionPoliciesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	s.Config = DataSourceConfiguration(req, resp)
+func (s *spaceDefaultLifecycleReleaseRetentionPoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	s.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 // Read implements datasource.DataSource.
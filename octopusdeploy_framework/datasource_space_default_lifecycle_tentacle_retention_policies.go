@@ -23,8 +23,8 @@ func (s *spaceDefaultLifecycleTentacleRetentionPoliciesDataSource) Metadata(_ co
 	resp.TypeName = util.GetTypeName("space_default_lifecycle_tentacle_retention_policy")
 }
 
-func (s *spaceDefaultLifecycleTentacleRetentionPoliciesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	s.Config = DataSourceConfiguration(req, resp)
+func (s *spaceDefaultLifecycleTentacleRetentionPoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	s.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 // Read implements datasource.DataSource.
@@ -23,8 +23,8 @@ func (s *spaceDefaultRunbookRetentionPoliciesDataSource) Metadata(_ context.Cont
 	resp.TypeName = util.GetTypeName("space_default_runbook_retention_policy")
 }
 
-func (s *spaceDefaultRunbookRetentionPoliciesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	s.Config = DataSourceConfiguration(req, resp)
+func (s *spaceDefaultRunbookRetentionPoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	s.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 // Read implements datasource.DataSource.
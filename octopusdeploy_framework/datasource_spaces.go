@@ -35,8 +35,8 @@ func (*spacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 	resp.Schema = schemas.SpacesSchema{}.GetDatasourceSchema()
 }
 
-func (b *spacesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	b.Config = DataSourceConfiguration(req, resp)
+func (b *spacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	b.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (b *spacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
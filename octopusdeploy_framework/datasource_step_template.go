@@ -28,8 +28,8 @@ func (*stepTemplateDataSource) Schema(_ context.Context, req datasource.SchemaRe
 	resp.Schema = schemas.StepTemplateSchema{}.GetDatasourceSchema()
 }
 
-func (d *stepTemplateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	d.Config = DataSourceConfiguration(req, resp)
+func (d *stepTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (d *stepTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -0,0 +1,166 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type stepTemplateUsageDataSource struct {
+	*Config
+}
+
+func NewStepTemplateUsageDataSource() datasource.DataSource {
+	return &stepTemplateUsageDataSource{}
+}
+
+func (d *stepTemplateUsageDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.StepTemplateUsageDataSourceName)
+}
+
+func (d *stepTemplateUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.StepTemplateUsageSchema{}.GetDatasourceSchema()
+}
+
+func (d *stepTemplateUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = DataSourceConfiguration(ctx, req, resp)
+}
+
+func (d *stepTemplateUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schemas.StepTemplateUsageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := data.SpaceID.ValueString()
+	templateID := data.StepTemplateID.ValueString()
+
+	util.DatasourceReading(ctx, "step template usage", templateID)
+
+	template, err := actiontemplates.GetByID(d.Client, spaceID, templateID)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to load step template", err.Error())
+		return
+	}
+
+	usages, err := getActionTemplateUsages(d.Client, template)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to load the usage of step template "+templateID, err.Error())
+		return
+	}
+
+	usageModels := make([]schemas.StepTemplateUsageModel, 0, len(usages))
+	for _, usage := range usages {
+		usageModel := flattenActionTemplateUsage(usage, template.Version)
+		if data.OutdatedOnly.ValueBool() && usageModel.IsLatestVersion.ValueBool() {
+			continue
+		}
+		usageModels = append(usageModels, usageModel)
+	}
+
+	util.DatasourceResultCount(ctx, "step template usages", len(usageModels))
+
+	usageList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: schemas.StepTemplateUsageObjectType()}, usageModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SpaceID = types.StringValue(template.SpaceID)
+	data.LatestVersion = types.Int64Value(int64(template.Version))
+	data.Usages = usageList
+	data.ID = types.StringValue("StepTemplateUsage " + time.Now().UTC().String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// actionTemplateUsage is a deployment process or runbook process action based on a step template, as returned by
+// the usage endpoint of the step template.
+type actionTemplateUsage struct {
+	ProjectID   string                     `json:"ProjectId"`
+	ProjectName string                     `json:"ProjectName"`
+	ProjectSlug string                     `json:"ProjectSlug"`
+	ProcessID   string                     `json:"ProcessId"`
+	ProcessType string                     `json:"ProcessType"`
+	RunbookID   string                     `json:"RunbookId"`
+	RunbookName string                     `json:"RunbookName"`
+	StepName    string                     `json:"StepName"`
+	ActionID    string                     `json:"ActionId"`
+	ActionName  string                     `json:"ActionName"`
+	Version     actionTemplateUsageVersion `json:"Version"`
+
+	// DeploymentProcessID is only set by Octopus Server versions that do not report usage in runbooks.
+	DeploymentProcessID string `json:"DeploymentProcessId"`
+}
+
+// actionTemplateUsageVersion is the step template version of a usage, which Octopus Server returns as a string.
+type actionTemplateUsageVersion int32
+
+func (v *actionTemplateUsageVersion) UnmarshalJSON(data []byte) error {
+	var version json.Number
+	if err := json.Unmarshal(data, &version); err != nil {
+		return err
+	}
+	parsed, err := strconv.ParseInt(version.String(), 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid step template version %s", data)
+	}
+	*v = actionTemplateUsageVersion(parsed)
+	return nil
+}
+
+func getActionTemplateUsages(octopus *client.Client, template *actiontemplates.ActionTemplate) ([]actionTemplateUsage, error) {
+	usagePath, ok := template.Links["Usage"]
+	if !ok {
+		usagePath = fmt.Sprintf("/api/%s/actiontemplates/%s/usage", template.SpaceID, template.GetID())
+	}
+
+	// The link is a URI template on some versions of Octopus Server
+	usagePath = strings.SplitN(usagePath, "{", 2)[0]
+	usages, err := newclient.Get[[]actionTemplateUsage](octopus.HttpSession(), usagePath)
+	if err != nil {
+		return nil, err
+	}
+	return *usages, nil
+}
+
+func flattenActionTemplateUsage(usage actionTemplateUsage, latestVersion int32) schemas.StepTemplateUsageModel {
+	processID := usage.ProcessID
+	processType := usage.ProcessType
+	if processID == "" {
+		processID = usage.DeploymentProcessID
+	}
+	if processType == "" {
+		processType = "Deployment"
+		if usage.RunbookID != "" {
+			processType = "Runbook"
+		}
+	}
+
+	return schemas.StepTemplateUsageModel{
+		ProjectID:       types.StringValue(usage.ProjectID),
+		ProjectName:     types.StringValue(usage.ProjectName),
+		ProjectSlug:     util.StringOrNull(usage.ProjectSlug),
+		ProcessID:       util.StringOrNull(processID),
+		ProcessType:     types.StringValue(processType),
+		RunbookID:       util.StringOrNull(usage.RunbookID),
+		RunbookName:     util.StringOrNull(usage.RunbookName),
+		StepName:        types.StringValue(usage.StepName),
+		ActionID:        types.StringValue(usage.ActionID),
+		ActionName:      types.StringValue(usage.ActionName),
+		Version:         types.Int64Value(int64(usage.Version)),
+		IsLatestVersion: types.BoolValue(int32(usage.Version) == latestVersion),
+	}
+}
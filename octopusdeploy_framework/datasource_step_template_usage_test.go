@@ -0,0 +1,31 @@
+package octopusdeploy_framework
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenActionTemplateUsage(t *testing.T) {
+	var usages []actionTemplateUsage
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"ProjectId": "Projects-1", "ProjectName": "Web", "ProjectSlug": "web", "DeploymentProcessId": "deploymentprocess-Projects-1", "StepName": "Deploy", "ActionId": "a1", "ActionName": "Deploy", "Version": "2"},
+		{"ProjectId": "Projects-1", "ProjectName": "Web", "ProcessId": "RunbookProcess-Runbooks-1", "RunbookId": "Runbooks-1", "RunbookName": "Restart", "StepName": "Restart", "ActionId": "a2", "ActionName": "Restart", "Version": 3}
+	]`), &usages))
+
+	deployment := flattenActionTemplateUsage(usages[0], 3)
+	assert.Equal(t, types.StringValue("deploymentprocess-Projects-1"), deployment.ProcessID)
+	assert.Equal(t, types.StringValue("Deployment"), deployment.ProcessType)
+	assert.Equal(t, types.StringNull(), deployment.RunbookID)
+	assert.Equal(t, types.Int64Value(2), deployment.Version)
+	assert.Equal(t, types.BoolValue(false), deployment.IsLatestVersion)
+
+	runbook := flattenActionTemplateUsage(usages[1], 3)
+	assert.Equal(t, types.StringValue("RunbookProcess-Runbooks-1"), runbook.ProcessID)
+	assert.Equal(t, types.StringValue("Runbook"), runbook.ProcessType)
+	assert.Equal(t, types.StringValue("Restart"), runbook.RunbookName)
+	assert.Equal(t, types.BoolValue(true), runbook.IsLatestVersion)
+}
@@ -31,8 +31,8 @@ func (t *tagSetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 	resp.Schema = schemas.TagSetSchema{}.GetDatasourceSchema()
 }
 
-func (t *tagSetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	t.Config = DataSourceConfiguration(req, resp)
+func (t *tagSetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	t.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (t *tagSetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
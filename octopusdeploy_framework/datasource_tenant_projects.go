@@ -25,8 +25,8 @@ func (*tenantProjectsDataSource) Metadata(_ context.Context, req datasource.Meta
 	resp.TypeName = util.GetTypeName("tenant_projects")
 }
 
-func (t *tenantProjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	t.Config = DataSourceConfiguration(req, resp)
+func (t *tenantProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	t.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (*tenantProjectsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
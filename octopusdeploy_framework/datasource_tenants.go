@@ -22,8 +22,8 @@ func (*tenantsDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 	resp.TypeName = util.GetTypeName("tenants")
 }
 
-func (e *tenantsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	e.Config = DataSourceConfiguration(req, resp)
+func (e *tenantsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	e.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (*tenantsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -0,0 +1,309 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &tentacleBootstrapDataSource{}
+
+type tentacleBootstrapDataSource struct {
+	*Config
+}
+
+func NewTentacleBootstrapDataSource() datasource.DataSource {
+	return &tentacleBootstrapDataSource{}
+}
+
+func (t *tentacleBootstrapDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.TentacleBootstrapDataSourceName)
+}
+
+func (t *tentacleBootstrapDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.TentacleBootstrapSchema{}.GetDatasourceSchema()
+}
+
+func (t *tentacleBootstrapDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return schemas.TentacleBootstrapSchema{}.GetDatasourceConfigValidators()
+}
+
+func (t *tentacleBootstrapDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	t.Config = DataSourceConfiguration(ctx, req, resp)
+}
+
+func (t *tentacleBootstrapDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schemas.TentacleBootstrapModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	util.DatasourceReading(ctx, "tentacle bootstrap", data.Name.ValueString())
+
+	if data.ServerURL.ValueString() == "" {
+		data.ServerURL = types.StringValue(t.Config.Address)
+	}
+	if data.ServerCommsPort.IsNull() || data.ServerCommsPort.IsUnknown() {
+		data.ServerCommsPort = types.Int64Value(schemas.TentacleBootstrapDefaultCommsPort)
+	}
+	if data.InstanceName.ValueString() == "" {
+		data.InstanceName = types.StringValue(schemas.TentacleBootstrapDefaultInstanceName)
+	}
+
+	if data.ServerThumbprint.ValueString() == "" {
+		thumbprint, err := getServerCertificateThumbprint(t.Config)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read the Octopus Server certificate, set server_thumbprint", err.Error())
+			return
+		}
+		data.ServerThumbprint = types.StringValue(thumbprint)
+	}
+
+	if data.SpaceName.ValueString() == "" {
+		spaceName, err := t.Config.GetSpaceName()
+		if err != nil {
+			resp.Diagnostics.AddError("unable to determine the space of the tentacle, set space_name", err.Error())
+			return
+		}
+		data.SpaceName = types.StringValue(spaceName)
+	}
+
+	bootstrap, err := newTentacleBootstrap(data)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to generate the tentacle configuration", err.Error())
+		return
+	}
+
+	linux, diags := types.ObjectValueFrom(ctx, tentacleBootstrapPlatformAttributeTypes(), bootstrap.platform(linuxTentacle))
+	resp.Diagnostics.Append(diags...)
+	windows, diags := types.ObjectValueFrom(ctx, tentacleBootstrapPlatformAttributeTypes(), bootstrap.platform(windowsTentacle))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(bootstrap.subscriptionURI)
+	data.SubscriptionURI = types.StringValue(bootstrap.subscriptionURI)
+	data.Linux = linux
+	data.Windows = windows
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func tentacleBootstrapPlatformAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"config_path":       types.StringType,
+		"tentacle_config":   types.StringType,
+		"configure_command": types.StringType,
+		"register_command":  types.StringType,
+		"service_command":   types.StringType,
+	}
+}
+
+// serverCertificate is the certificate the Octopus Server identifies itself to tentacles with.
+type serverCertificate struct {
+	Thumbprint string `json:"Thumbprint"`
+}
+
+func getServerCertificateThumbprint(config *Config) (string, error) {
+	certificate, err := newclient.Get[serverCertificate](config.Client.HttpSession(), "/api/configuration/certificates/certificate-global")
+	if err != nil {
+		return "", err
+	}
+	if certificate.Thumbprint == "" {
+		return "", fmt.Errorf("the Octopus Server did not return a certificate thumbprint")
+	}
+	return certificate.Thumbprint, nil
+}
+
+// tentaclePlatform holds the paths and shell conventions of a tentacle installation.
+type tentaclePlatform struct {
+	executable     string
+	home           func(instance string) string
+	configPath     func(instance string) string
+	applicationDir string
+	apiKey         string
+	quote          func(value string) string
+	lineSeparator  string
+}
+
+var linuxTentacle = tentaclePlatform{
+	executable: "/opt/octopus/tentacle/Tentacle",
+	home:       func(instance string) string { return "/etc/octopus/" + instance },
+	configPath: func(instance string) string {
+		return fmt.Sprintf("/etc/octopus/%s/tentacle-%s.config", instance, instance)
+	},
+	applicationDir: "/home/Octopus/Applications",
+	apiKey:         `"$OCTOPUS_API_KEY"`,
+	quote:          func(value string) string { return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'" },
+	lineSeparator:  " \\\n  ",
+}
+
+var windowsTentacle = tentaclePlatform{
+	executable:     `& "C:\Program Files\Octopus Deploy\Tentacle\Tentacle.exe"`,
+	home:           func(string) string { return `C:\Octopus` },
+	configPath:     func(instance string) string { return `C:\Octopus\` + instance + ".config" },
+	applicationDir: `C:\Octopus\Applications`,
+	apiKey:         "$env:OCTOPUS_API_KEY",
+	quote:          func(value string) string { return "'" + strings.ReplaceAll(value, "'", "''") + "'" },
+	lineSeparator:  " `\n  ",
+}
+
+type tentacleBootstrap struct {
+	data            schemas.TentacleBootstrapModel
+	subscriptionURI string
+	trustedServers  string
+	isWorker        bool
+}
+
+func newTentacleBootstrap(data schemas.TentacleBootstrapModel) (*tentacleBootstrap, error) {
+	subscriptionURI := getSubscriptionURI(data.SubscriptionID.ValueString())
+
+	parsed, err := url.Parse(data.ServerURL.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Hostname() == "" {
+		return nil, fmt.Errorf("the server URL '%s' has no host", data.ServerURL.ValueString())
+	}
+	serverAddress := fmt.Sprintf("https://%s/", net.JoinHostPort(parsed.Hostname(), strconv.FormatInt(data.ServerCommsPort.ValueInt64(), 10)))
+
+	// CommunicationStyle 2 is TentacleActive, a polling tentacle.
+	trustedServers, err := json.Marshal([]map[string]any{{
+		"Thumbprint":         data.ServerThumbprint.ValueString(),
+		"CommunicationStyle": 2,
+		"Address":            serverAddress,
+		"Squid":              nil,
+		"SubscriptionId":     subscriptionURI,
+	}})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tentacleBootstrap{
+		data:            data,
+		subscriptionURI: subscriptionURI,
+		trustedServers:  string(trustedServers),
+		isWorker:        len(util.ExpandStringList(data.WorkerPools)) > 0,
+	}, nil
+}
+
+// getSubscriptionURI accepts a subscription ID or a poll:// URI, and returns the poll:// URI.
+func getSubscriptionURI(subscriptionID string) string {
+	id := strings.TrimSuffix(strings.TrimPrefix(subscriptionID, "poll://"), "/")
+	return "poll://" + id + "/"
+}
+
+func (b *tentacleBootstrap) platform(platform tentaclePlatform) schemas.TentacleBootstrapPlatformModel {
+	instance := b.data.InstanceName.ValueString()
+	return schemas.TentacleBootstrapPlatformModel{
+		ConfigPath:       types.StringValue(platform.configPath(instance)),
+		TentacleConfig:   types.StringValue(b.tentacleConfig(platform)),
+		ConfigureCommand: types.StringValue(b.configureCommand(platform)),
+		RegisterCommand:  types.StringValue(b.registerCommand(platform)),
+		ServiceCommand:   types.StringValue(platform.command("service", "--instance", platform.quote(instance), "--install", "--start")),
+	}
+}
+
+func (b *tentacleBootstrap) tentacleConfig(platform tentaclePlatform) string {
+	settings := [][2]string{
+		{"Octopus.Home", platform.home(b.data.InstanceName.ValueString())},
+		{"Tentacle.Certificate", b.data.Certificate.ValueString()},
+		{"Tentacle.CertificateThumbprint", b.data.CertificateThumbprint.ValueString()},
+		{"Tentacle.Communication.TrustedOctopusServers", b.trustedServers},
+		{"Tentacle.Deployment.ApplicationDirectory", platform.applicationDir},
+		{"Tentacle.Services.NoListen", "True"},
+	}
+
+	var config strings.Builder
+	config.WriteString("<?xml version=\"1.0\" encoding=\"utf-8\"?>\n<octopus-settings xmlns:xsd=\"http://www.w3.org/2001/XMLSchema\" xmlns:xsi=\"http://www.w3.org/2001/XMLSchema-instance\">\n")
+	for _, setting := range settings {
+		config.WriteString("  <set key=\"")
+		xml.EscapeText(&config, []byte(setting[0]))
+		config.WriteString("\">")
+		xml.EscapeText(&config, []byte(setting[1]))
+		config.WriteString("</set>\n")
+	}
+	config.WriteString("</octopus-settings>\n")
+	return config.String()
+}
+
+func (b *tentacleBootstrap) configureCommand(platform tentaclePlatform) string {
+	instance := platform.quote(b.data.InstanceName.ValueString())
+	return strings.Join([]string{
+		platform.command("create-instance", "--instance", instance, "--config", platform.quote(platform.configPath(b.data.InstanceName.ValueString()))),
+		platform.command("configure", "--instance", instance,
+			"--home", platform.quote(platform.home(b.data.InstanceName.ValueString())),
+			"--app", platform.quote(platform.applicationDir),
+			"--noListen", "True"),
+	}, "\n")
+}
+
+func (b *tentacleBootstrap) registerCommand(platform tentaclePlatform) string {
+	quoteList := func(option string, list types.List) []string {
+		var args []string
+		for _, value := range util.ExpandStringList(list) {
+			args = append(args, option, platform.quote(value))
+		}
+		return args
+	}
+
+	verb := "register-with"
+	if b.isWorker {
+		verb = "register-worker"
+	}
+
+	args := []string{
+		"--instance", platform.quote(b.data.InstanceName.ValueString()),
+		"--server", platform.quote(b.data.ServerURL.ValueString()),
+		"--apiKey", platform.apiKey,
+		"--space", platform.quote(b.data.SpaceName.ValueString()),
+		"--name", platform.quote(b.data.Name.ValueString()),
+		"--comms-style", "TentacleActive",
+		"--server-comms-port", strconv.FormatInt(b.data.ServerCommsPort.ValueInt64(), 10),
+	}
+	if policy := b.data.MachinePolicy.ValueString(); policy != "" {
+		args = append(args, "--policy", platform.quote(policy))
+	}
+
+	if b.isWorker {
+		args = append(args, quoteList("--workerpool", b.data.WorkerPools)...)
+	} else {
+		args = append(args, quoteList("--environment", b.data.Environments)...)
+		args = append(args, quoteList("--role", b.data.Roles)...)
+		args = append(args, quoteList("--tenant", b.data.Tenants)...)
+		args = append(args, quoteList("--tenanttag", b.data.TenantTags)...)
+		if participation := b.data.TenantedDeploymentParticipation.ValueString(); participation != "" {
+			args = append(args, "--tenanted-deployment-participation", participation)
+		}
+	}
+
+	return platform.command(verb, args...)
+}
+
+// command returns a Tentacle command line, with an option and its value on each line.
+func (p tentaclePlatform) command(verb string, args ...string) string {
+	lines := []string{p.executable + " " + verb}
+	for i := 0; i < len(args); i++ {
+		if i+1 < len(args) && !strings.HasPrefix(args[i+1], "--") {
+			lines = append(lines, args[i]+" "+args[i+1])
+			i++
+		} else {
+			lines = append(lines, args[i])
+		}
+	}
+	return strings.Join(lines, p.lineSeparator)
+}
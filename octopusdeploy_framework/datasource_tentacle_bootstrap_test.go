@@ -0,0 +1,86 @@
+package octopusdeploy_framework
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestTentacleBootstrapModel() schemas.TentacleBootstrapModel {
+	return schemas.TentacleBootstrapModel{
+		Name:                  types.StringValue("Web's Server"),
+		SubscriptionID:        types.StringValue("abcdefghijklmnopqrst"),
+		Certificate:           types.StringValue("MIIJ..."),
+		CertificateThumbprint: types.StringValue("TENTACLETHUMBPRINT"),
+		ServerURL:             types.StringValue("https://octopus.example.com:8080"),
+		ServerCommsPort:       types.Int64Value(10943),
+		ServerThumbprint:      types.StringValue("SERVERTHUMBPRINT"),
+		SpaceName:             types.StringValue("Default"),
+		InstanceName:          types.StringValue("Tentacle"),
+		Environments:          util.FlattenStringList([]string{"Production"}),
+		Roles:                 util.FlattenStringList([]string{"web"}),
+		WorkerPools:           types.ListNull(types.StringType),
+	}
+}
+
+func TestTentacleBootstrapConfig(t *testing.T) {
+	bootstrap, err := newTentacleBootstrap(getTestTentacleBootstrapModel())
+	require.NoError(t, err)
+
+	assert.Equal(t, "poll://abcdefghijklmnopqrst/", bootstrap.subscriptionURI)
+
+	linux := bootstrap.platform(linuxTentacle)
+	assert.Equal(t, "/etc/octopus/Tentacle/tentacle-Tentacle.config", linux.ConfigPath.ValueString())
+
+	config := linux.TentacleConfig.ValueString()
+	assert.Contains(t, config, `<set key="Octopus.Home">/etc/octopus/Tentacle</set>`)
+	assert.Contains(t, config, `<set key="Tentacle.CertificateThumbprint">TENTACLETHUMBPRINT</set>`)
+	assert.Contains(t, config, `<set key="Tentacle.Services.NoListen">True</set>`)
+	assert.Contains(t, config, `&#34;Address&#34;:&#34;https://octopus.example.com:10943/&#34;`)
+	assert.Contains(t, config, `&#34;SubscriptionId&#34;:&#34;poll://abcdefghijklmnopqrst/&#34;`)
+
+	configure := linux.ConfigureCommand.ValueString()
+	assert.Contains(t, configure, "--config '/etc/octopus/Tentacle/tentacle-Tentacle.config'")
+	assert.NotContains(t, configure, "import-certificate", "The certificate is read from the configuration file")
+
+	windows := bootstrap.platform(windowsTentacle)
+	assert.Equal(t, `C:\Octopus\Tentacle.config`, windows.ConfigPath.ValueString())
+	assert.Contains(t, windows.TentacleConfig.ValueString(), `<set key="Octopus.Home">C:\Octopus</set>`)
+}
+
+func TestTentacleBootstrapRegisterCommand(t *testing.T) {
+	bootstrap, err := newTentacleBootstrap(getTestTentacleBootstrapModel())
+	require.NoError(t, err)
+
+	linux := bootstrap.platform(linuxTentacle).RegisterCommand.ValueString()
+	assert.Contains(t, linux, "/opt/octopus/tentacle/Tentacle register-with \\\n")
+	assert.Contains(t, linux, `--name 'Web'\''s Server'`)
+	assert.Contains(t, linux, `--apiKey "$OCTOPUS_API_KEY"`)
+	assert.Contains(t, linux, "--environment 'Production'")
+	assert.Contains(t, linux, "--comms-style TentacleActive")
+
+	windows := bootstrap.platform(windowsTentacle).RegisterCommand.ValueString()
+	assert.Contains(t, windows, `--name 'Web''s Server'`)
+	assert.Contains(t, windows, "--apiKey $env:OCTOPUS_API_KEY")
+
+	data := getTestTentacleBootstrapModel()
+	data.Environments = types.ListNull(types.StringType)
+	data.Roles = types.ListNull(types.StringType)
+	data.WorkerPools = util.FlattenStringList([]string{"Linux Workers"})
+	bootstrap, err = newTentacleBootstrap(data)
+	require.NoError(t, err)
+
+	worker := bootstrap.platform(linuxTentacle).RegisterCommand.ValueString()
+	assert.Contains(t, worker, "register-worker")
+	assert.Contains(t, worker, "--workerpool 'Linux Workers'")
+	assert.NotContains(t, worker, "--environment")
+}
+
+func TestGetSubscriptionURI(t *testing.T) {
+	assert.Equal(t, "poll://abc/", getSubscriptionURI("abc"))
+	assert.Equal(t, "poll://abc/", getSubscriptionURI("poll://abc/"))
+}
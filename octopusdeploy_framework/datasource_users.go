@@ -40,8 +40,8 @@ func (u *userDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 	resp.Schema = schemas.UserSchema{}.GetDatasourceSchema()
 }
 
-func (u *userDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	u.Config = DataSourceConfiguration(req, resp)
+func (u *userDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	u.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (u *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -0,0 +1,128 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type variablePreviewDataSource struct {
+	*Config
+}
+
+func NewVariablePreviewDataSource() datasource.DataSource {
+	return &variablePreviewDataSource{}
+}
+
+func (d *variablePreviewDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.VariablePreviewDataSourceName)
+}
+
+func (d *variablePreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schemas.VariablePreviewSchema{}.GetDatasourceSchema()
+}
+
+func (d *variablePreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = DataSourceConfiguration(ctx, req, resp)
+}
+
+func (d *variablePreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schemas.VariablePreviewDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := data.SpaceID.ValueString()
+	if spaceID == "" {
+		spaceID = d.Client.GetSpaceID()
+	}
+
+	util.DatasourceReading(ctx, "variable preview", data.ProjectID.ValueString())
+
+	query := variables.VariablePreviewQuery{
+		Project:     data.ProjectID.ValueString(),
+		Channel:     data.ChannelID.ValueString(),
+		Environment: data.EnvironmentID.ValueString(),
+		Tenant:      data.TenantID.ValueString(),
+		Machine:     data.MachineID.ValueString(),
+		Runbook:     data.RunbookID.ValueString(),
+	}
+	preview, err := getVariablePreview(d.Client, spaceID, query)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to load variable preview", err.Error())
+		return
+	}
+
+	variableModels, values := flattenVariablePreview(preview)
+
+	util.DatasourceResultCount(ctx, "variables", len(variableModels))
+
+	variableList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: schemas.VariablePreviewObjectType()}, variableModels)
+	resp.Diagnostics.Append(diags...)
+	valueMap, diags := types.MapValueFrom(ctx, types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SpaceID = types.StringValue(spaceID)
+	data.Variables = variableList
+	data.Values = valueMap
+	data.ID = types.StringValue("VariablePreview " + time.Now().UTC().String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getVariablePreview loads the variables that apply in a deployment context from the variable preview endpoint.
+func getVariablePreview(octopus *client.Client, spaceID string, query variables.VariablePreviewQuery) (*variables.VariableSet, error) {
+	parameters := url.Values{}
+	for name, value := range map[string]string{
+		"project":     query.Project,
+		"channel":     query.Channel,
+		"environment": query.Environment,
+		"tenant":      query.Tenant,
+		"machine":     query.Machine,
+		"runbook":     query.Runbook,
+	} {
+		if value != "" {
+			parameters.Set(name, value)
+		}
+	}
+
+	previewPath := fmt.Sprintf("/api/%s/variables/preview?%s", spaceID, parameters.Encode())
+	return newclient.Get[variables.VariableSet](octopus.HttpSession(), previewPath)
+}
+
+// flattenVariablePreview returns the variables of a preview and their values by name. The values of sensitive
+// variables are masked.
+func flattenVariablePreview(preview *variables.VariableSet) ([]schemas.VariablePreviewModel, map[string]string) {
+	variableModels := make([]schemas.VariablePreviewModel, 0, len(preview.Variables))
+	values := make(map[string]string, len(preview.Variables))
+	for _, variable := range preview.Variables {
+		value := variable.Value
+		if variable.IsSensitive {
+			value = schemas.VariablePreviewSensitiveValue
+		}
+
+		variableModels = append(variableModels, schemas.VariablePreviewModel{
+			ID:          types.StringValue(variable.GetID()),
+			Name:        types.StringValue(variable.Name),
+			Value:       types.StringValue(value),
+			Type:        types.StringValue(variable.Type),
+			Description: types.StringValue(variable.Description),
+			IsSensitive: types.BoolValue(variable.IsSensitive),
+		})
+		values[variable.Name] = value
+	}
+	return variableModels, values
+}
@@ -0,0 +1,35 @@
+package octopusdeploy_framework
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenVariablePreview(t *testing.T) {
+	var preview variables.VariableSet
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"Variables": [
+			{"Id": "v1", "Name": "Database.Name", "Value": "orders-production", "Type": "String", "Description": "The database"},
+			{"Id": "v2", "Name": "Database.Password", "Value": null, "Type": "Sensitive", "IsSensitive": true}
+		]
+	}`), &preview))
+
+	variableModels, values := flattenVariablePreview(&preview)
+
+	require.Len(t, variableModels, 2)
+	assert.Equal(t, types.StringValue("orders-production"), variableModels[0].Value)
+	assert.Equal(t, types.StringValue("The database"), variableModels[0].Description)
+	assert.Equal(t, types.BoolValue(false), variableModels[0].IsSensitive)
+	assert.Equal(t, types.StringValue(schemas.VariablePreviewSensitiveValue), variableModels[1].Value)
+	assert.Equal(t, types.BoolValue(true), variableModels[1].IsSensitive)
+	assert.Equal(t, map[string]string{
+		"Database.Name":     "orders-production",
+		"Database.Password": schemas.VariablePreviewSensitiveValue,
+	}, values)
+}
@@ -28,8 +28,8 @@ func (*variablesDataSource) Schema(_ context.Context, req datasource.SchemaReque
 	resp.Schema = schemas.VariableSchema{}.GetDatasourceSchema()
 }
 
-func (e *variablesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	e.Config = DataSourceConfiguration(req, resp)
+func (e *variablesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	e.Config = DataSourceConfiguration(ctx, req, resp)
 }
 
 func (v *variablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
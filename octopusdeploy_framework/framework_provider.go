@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/credentials"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -13,10 +14,13 @@ import (
 )
 
 type octopusDeployFrameworkProvider struct {
-	Address     types.String `tfsdk:"address"`
-	ApiKey      types.String `tfsdk:"api_key"`
-	AccessToken types.String `tfsdk:"access_token"`
-	SpaceID     types.String `tfsdk:"space_id"`
+	Address           types.String `tfsdk:"address"`
+	ApiKey            types.String `tfsdk:"api_key"`
+	AccessToken       types.String `tfsdk:"access_token"`
+	CredentialCommand types.List   `tfsdk:"credential_command"`
+	Profile           types.String `tfsdk:"profile"`
+	SpaceID           types.String `tfsdk:"space_id"`
+	SpaceName         types.String `tfsdk:"space_name"`
 }
 
 var _ provider.Provider = (*octopusDeployFrameworkProvider)(nil)
@@ -41,6 +45,18 @@ func (p *octopusDeployFrameworkProvider) Configure(ctx context.Context, req prov
 		return
 	}
 
+	if isConnectionUnknown(providerData) {
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+			return
+		}
+
+		config := &Config{Offline: true}
+		resp.DataSourceData = config
+		resp.ResourceData = config
+		return
+	}
+
 	config := Config{}
 	config.ApiKey = providerData.ApiKey.ValueString()
 	if config.ApiKey == "" {
@@ -58,15 +74,55 @@ func (p *octopusDeployFrameworkProvider) Configure(ctx context.Context, req prov
 		config.Address = os.Getenv("OCTOPUS_URL")
 	}
 	config.SpaceID = providerData.SpaceID.ValueString()
+	config.SpaceName = providerData.SpaceName.ValueString()
+	config.CredentialCommand = util.ExpandStringList(providerData.CredentialCommand)
 
-	if diags := config.SetOctopus(ctx); diags.HasError() {
-		resp.Diagnostics.Append(diags...)
+	profile := providerData.Profile.ValueString()
+	if profile == "" {
+		profile = os.Getenv(credentials.ProfileEnvVar)
+	}
+	if profile != "" {
+		cliProfile, err := credentials.LoadCliProfile(profile)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to load Octopus CLI profile", err.Error())
+			return
+		}
+		applyCliProfile(&config, cliProfile)
 	}
 
 	resp.DataSourceData = &config
 	resp.ResourceData = &config
 }
 
+// isConnectionUnknown reports whether any of the settings used to connect to the Octopus Server depend on values
+// that are not known until apply, e.g. an address output by a resource in the same configuration.
+func isConnectionUnknown(providerData octopusDeployFrameworkProvider) bool {
+	return providerData.Address.IsUnknown() ||
+		providerData.ApiKey.IsUnknown() ||
+		providerData.AccessToken.IsUnknown() ||
+		providerData.CredentialCommand.IsUnknown() ||
+		providerData.Profile.IsUnknown() ||
+		providerData.SpaceID.IsUnknown() ||
+		providerData.SpaceName.IsUnknown()
+}
+
+// applyCliProfile fills in any connection settings that were not set on the provider or through environment variables.
+func applyCliProfile(config *Config, profile *credentials.CliProfile) {
+	if config.Address == "" {
+		config.Address = profile.Url
+	}
+
+	if config.ApiKey == "" && config.AccessToken == "" && len(config.CredentialCommand) == 0 {
+		config.ApiKey = profile.ApiKey
+		config.AccessToken = profile.AccessToken
+	}
+
+	if config.SpaceID == "" && config.SpaceName == "" {
+		config.SpaceID = profile.SpaceID()
+		config.SpaceName = profile.SpaceName()
+	}
+}
+
 func (p *octopusDeployFrameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewProjectGroupsDataSource,
@@ -76,11 +132,16 @@ func (p *octopusDeployFrameworkProvider) DataSources(ctx context.Context) []func
 		NewEnvironmentsDataSource,
 		NewParentEnvironmentsDataSource,
 		NewStepTemplateDataSource,
+		NewStepTemplateUsageDataSource,
 		NewCommunityStepTemplateDataSource,
 		NewGitCredentialsDataSource,
 		NewFeedsDataSource,
+		NewFeedPackageVersionsDataSource,
 		NewLibraryVariableSetDataSource,
 		NewVariablesDataSource,
+		NewVariablePreviewDataSource,
+		NewReleasesDataSource,
+		NewDeploymentsDataSource,
 		NewProjectsDataSource,
 		NewMachineProxyDataSource,
 		NewTenantsDataSource,
@@ -94,6 +155,10 @@ func (p *octopusDeployFrameworkProvider) DataSources(ctx context.Context) []func
 		NewSpaceDefaultLifecycleReleaseRetentionPoliciesDataSource,
 		NewSpaceDefaultLifecycleTentacleRetentionPoliciesDataSource,
 		NewSpaceDefaultRunbookRetentionPoliciesDataSource,
+		NewDeprecationsDataSource,
+		NewKubernetesAgentHelmValuesDataSource,
+		NewTentacleBootstrapDataSource,
+		NewMachinesDataSource,
 	}
 }
 
@@ -128,13 +193,17 @@ func (p *octopusDeployFrameworkProvider) Resources(ctx context.Context) []func()
 		NewArtifactoryGenericFeedResource,
 		NewGitHubRepositoryFeedResource,
 		NewAwsElasticContainerRegistryFeedResource,
+		NewPackageResource,
+		NewBuildInformationResource,
 		NewNugetFeedResource,
 		NewGcsStorageFeedResource,
 		NewNpmFeedResource,
 		NewPyPiFeedResource,
 		NewTenantProjectResource,
+		NewProjectTenantConnectionsResource,
 		NewTenantProjectVariableResource,
 		NewTenantCommonVariableResource,
+		NewTenantVariablesResource,
 		NewLibraryVariableSetFeedResource,
 		NewVariableResource,
 		NewProjectResource,
@@ -194,10 +263,23 @@ func (p *octopusDeployFrameworkProvider) Schema(_ context.Context, req provider.
 				Optional:    true,
 				Description: "The OIDC Access Token to use with the Octopus REST API",
 			},
+			"credential_command": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "A command and its arguments that prints a JSON document with an `access_token` or `api_key` and an optional `expires_at` (RFC 3339) or `expires_in` (seconds). The command is run again when the credential expires. Used when neither an API key nor an access token is configured",
+			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of an Octopus CLI profile to read the server URL, credentials and space from. The `default` profile is the configuration written by `octopus login`; other names are read from the provider-specific `profiles` object of the CLI configuration file. Settings configured on the provider or through environment variables take precedence. Can also be set with the OCTOPUS_PROFILE environment variable",
+			},
 			"space_id": schema.StringAttribute{
 				Optional:    true,
 				Description: "The space ID to target",
 			},
+			"space_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the space to target, as an alternative to space_id",
+			},
 		},
 	}
 }
@@ -42,6 +42,12 @@ func (p *testOctopusDeployFrameworkProvider) Configure(ctx context.Context, req
 			return
 		}
 
+		// Load the server feature toggles first so connecting later does not replace the overrides
+		resp.Diagnostics.Append(config.Connect(ctx)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		if config.FeatureToggles == nil {
 			config.FeatureToggles = make(map[string]bool)
 		}
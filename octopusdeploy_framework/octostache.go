@@ -0,0 +1,124 @@
+package octopusdeploy_framework
+
+import (
+	"slices"
+	"strings"
+)
+
+// octostacheReferences returns the names of the variables that an Octostache template references, in the order they
+// are first referenced. Substitutions (#{Name}), filters (#{Name | Filter}), conditions (#{if Name}, #{unless Name},
+// #{if Name == "value"}) and iterations (#{each item in Name}) are analysed. The iteration variables of #{each} blocks,
+// quoted literals and escaped expressions (##{Name}) are not references.
+func octostacheReferences(template string) []string {
+	var references []string
+	var iterators []string
+
+	add := func(name string) {
+		name = strings.TrimSpace(name)
+		if name == "" || isOctostacheLiteral(name) || isOctostacheIteratorReference(name, iterators) || slices.Contains(references, name) {
+			return
+		}
+		references = append(references, name)
+	}
+
+	for position := 0; position < len(template); {
+		start := strings.Index(template[position:], "#{")
+		if start < 0 {
+			break
+		}
+		start += position
+
+		end := octostacheExpressionEnd(template, start)
+		if end < 0 {
+			break // An unterminated expression is written to the output as is
+		}
+		position = end + 1
+
+		if start > 0 && template[start-1] == '#' {
+			continue // ##{ escapes an expression
+		}
+
+		expression := strings.TrimSpace(template[start+2 : end])
+		if strings.Contains(expression, "#{") {
+			// The name of the variable is built from other expressions during the deployment, only the expressions
+			// it is built from are known
+			for _, name := range octostacheReferences(expression) {
+				add(name)
+			}
+			continue
+		}
+
+		keyword, arguments, _ := strings.Cut(expression, " ")
+		switch keyword {
+		case "/if", "/unless", "else":
+		case "/each":
+			if len(iterators) > 0 {
+				iterators = iterators[:len(iterators)-1]
+			}
+		case "if", "unless":
+			condition, _, _ := strings.Cut(arguments, "|")
+			for _, operand := range splitOctostacheCondition(condition) {
+				add(operand)
+			}
+		case "each":
+			iterator, collection, ok := strings.Cut(arguments, " in ")
+			if !ok {
+				continue
+			}
+			collection, _, _ = strings.Cut(collection, "|")
+			add(collection)
+			iterators = append(iterators, strings.TrimSpace(iterator))
+		default:
+			name, _, _ := strings.Cut(expression, "|")
+			add(name)
+		}
+	}
+
+	return references
+}
+
+// octostacheExpressionEnd returns the position of the brace that closes the expression starting at a position, or -1
+// when the expression is not closed. Expressions can be nested.
+func octostacheExpressionEnd(template string, start int) int {
+	depth := 0
+	for i := start; i < len(template); i++ {
+		switch {
+		case strings.HasPrefix(template[i:], "#{"):
+			depth++
+			i++
+		case template[i] == '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splitOctostacheCondition returns the operands of a condition, which compares two operands with == or != or tests a
+// single operand.
+func splitOctostacheCondition(condition string) []string {
+	for _, operator := range []string{"==", "!="} {
+		if left, right, ok := strings.Cut(condition, operator); ok {
+			return []string{left, right}
+		}
+	}
+	return []string{condition}
+}
+
+func isOctostacheLiteral(operand string) bool {
+	if len(operand) >= 2 && (operand[0] == '"' || operand[0] == '\'') && operand[len(operand)-1] == operand[0] {
+		return true
+	}
+	return strings.EqualFold(operand, "true") || strings.EqualFold(operand, "false")
+}
+
+func isOctostacheIteratorReference(name string, iterators []string) bool {
+	for _, iterator := range iterators {
+		if name == iterator || strings.HasPrefix(name, iterator+".") || strings.HasPrefix(name, iterator+"[") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package octopusdeploy_framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOctostacheReferences(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		expected []string
+	}{
+		{name: "no expressions", template: "Write-Host 'Hello'", expected: nil},
+		{name: "substitution", template: "Write-Host '#{Greeting}, #{ Name }'", expected: []string{"Greeting", "Name"}},
+		{name: "repeated reference", template: "#{Name} #{Name}", expected: []string{"Name"}},
+		{name: "filters", template: "#{Name | ToUpper | Replace \"a\" \"b\"}", expected: []string{"Name"}},
+		{name: "escaped expression", template: "##{Name} #{Other}", expected: []string{"Other"}},
+		{name: "condition", template: "#{if Enabled}on#{else}off#{/if}", expected: []string{"Enabled"}},
+		{name: "negated condition", template: "#{unless Disabled}on#{/unless}", expected: []string{"Disabled"}},
+		{name: "comparison with literal", template: "#{if Environment == \"Production\"}prod#{/if}", expected: []string{"Environment"}},
+		{name: "comparison of variables", template: "#{if Left != Right}different#{/if}", expected: []string{"Left", "Right"}},
+		{
+			name:     "iteration",
+			template: "#{each server in Servers}#{server} #{server.Port} #{Suffix}#{/each} #{server}",
+			expected: []string{"Servers", "Suffix", "server"},
+		},
+		{name: "indexed variable", template: "#{Servers[Web].Port}", expected: []string{"Servers[Web].Port"}},
+		{name: "nested expression", template: "#{Connection[#{Environment}]}", expected: []string{"Environment"}},
+		{name: "unterminated expression", template: "#{Name} #{Other", expected: []string{"Name"}},
+		{name: "system variables", template: "#{Octopus.Release.Number}", expected: []string{"Octopus.Release.Number"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, octostacheReferences(test.template))
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package octopusdeploy_framework
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// processStepActionDefinition is the entry of a built-in action type in the bundled property catalogue. The properties
+// of the typed block of the action type are part of the definition, including their allowed and required values.
+type processStepActionDefinition struct {
+	block    schemas.ProcessStepActionBlock
+	keys     []string
+	prefixes []string
+}
+
+// Execution properties that any built-in action type accepts
+var processStepCommonPropertyKeys = []string{
+	"Octopus.Action.RunOnServer",
+	"Octopus.Action.EnabledFeatures",
+	"Octopus.Action.Package.FeedId",
+	"Octopus.Action.Package.PackageId",
+	"Octopus.Action.Package.DownloadOnTentacle",
+	"Octopus.Action.AutoRetry.MaximumCount",
+	"Octopus.Action.AutoRetry.MinimumBackoff",
+	"Octopus.Action.ExecutionTimeout.Minutes",
+}
+
+// Namespaces of execution properties that features and cloud accounts add to any built-in action type
+var processStepCommonPropertyPrefixes = []string{
+	"Octopus.Action.CustomScripts.",
+	"Octopus.Action.SubstituteInFiles.",
+	"Octopus.Action.StructuredConfigurationVariables.",
+	"Octopus.Action.GitRepository.",
+	"Octopus.Action.Aws.",
+	"Octopus.Action.AwsAccount.",
+	"Octopus.Action.Azure.",
+	"Octopus.Action.GoogleCloud.",
+	"Octopus.Action.GoogleCloudAccount.",
+}
+
+// processStepPropertyCatalogue is the bundled catalogue of the execution properties of built-in action types, by
+// action type. Steps of other action types are not validated.
+var processStepPropertyCatalogue = newProcessStepPropertyCatalogue(map[string]processStepActionDefinition{
+	"Octopus.Script": {},
+	"Octopus.KubernetesDeployRawYaml": {
+		prefixes: []string{"Octopus.Action.KubernetesContainers."},
+	},
+	"Octopus.HelmChartUpgrade": {
+		keys:     []string{"Octopus.Action.Kubernetes.ResourceStatusCheck"},
+		prefixes: []string{"Octopus.Action.Helm."},
+	},
+	"Octopus.TentaclePackage": {
+		prefixes: []string{
+			"Octopus.Action.Package.",
+			"Octopus.Action.IISWebSite.",
+			"Octopus.Action.IISVirtualDirectory.",
+			"Octopus.Action.WindowsService.",
+			"Octopus.Action.Nginx.",
+		},
+	},
+	"Octopus.Manual":        {},
+	"Octopus.Email":         {},
+	"Octopus.DeployRelease": {},
+})
+
+func newProcessStepPropertyCatalogue(definitions map[string]processStepActionDefinition) map[string]processStepActionDefinition {
+	catalogue := make(map[string]processStepActionDefinition, len(definitions))
+	for _, block := range schemas.ProcessStepActionBlocks {
+		definition, ok := definitions[block.ActionType]
+		if !ok {
+			continue
+		}
+
+		definition.block = block
+		definition.keys = slices.Concat(processStepCommonPropertyKeys, definition.keys)
+		for _, property := range block.Properties {
+			if !slices.Contains(definition.keys, property.Key) {
+				definition.keys = append(definition.keys, property.Key)
+			}
+		}
+		definition.prefixes = slices.Concat(processStepCommonPropertyPrefixes, definition.prefixes)
+		catalogue[block.ActionType] = definition
+	}
+	return catalogue
+}
+
+func (d processStepActionDefinition) hasPrefix(key string) bool {
+	return slices.ContainsFunc(d.prefixes, func(prefix string) bool { return strings.HasPrefix(key, prefix) })
+}
+
+// validateProcessStepExecutionProperties checks the execution properties of a step of a built-in action type against
+// the bundled property catalogue. A property that is a misspelling of a known property, a value that is not allowed and
+// a missing required property are errors. Other unknown properties are passed to Octopus and reported as warnings.
+func validateProcessStepExecutionProperties(actionType types.String, executionProperties types.Map, blocks map[string]types.Object) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if actionType.IsNull() || actionType.IsUnknown() || executionProperties.IsNull() || executionProperties.IsUnknown() {
+		return diags
+	}
+
+	definition, ok := processStepPropertyCatalogue[actionType.ValueString()]
+	if !ok {
+		return diags
+	}
+
+	properties := make(map[string]types.String, len(executionProperties.Elements()))
+	for key, value := range executionProperties.Elements() {
+		if property, ok := value.(types.String); ok {
+			properties[key] = property
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(properties)) {
+		propertyPath := path.Root("execution_properties").AtMapKey(key)
+		if slices.Contains(definition.keys, key) {
+			continue
+		}
+		if !strings.HasPrefix(key, "Octopus.Action.") {
+			continue // Properties outside the namespace of Octopus actions are not in the catalogue
+		}
+
+		if suggestion := closestName(key, definition.keys); suggestion != "" {
+			diags.AddAttributeError(
+				propertyPath,
+				"Unknown execution property",
+				fmt.Sprintf("%q is not an execution property of %s steps. Did you mean %q?", key, actionType.ValueString(), suggestion),
+			)
+			continue
+		}
+		if !definition.hasPrefix(key) {
+			diags.AddAttributeWarning(
+				propertyPath,
+				"Unknown execution property",
+				fmt.Sprintf("%q is not a known execution property of %s steps. It is passed to Octopus Deploy as is, check that the key is spelled correctly.", key, actionType.ValueString()),
+			)
+		}
+	}
+
+	// Values and required properties of a configured block are validated by the schema of the block
+	block := definition.block
+	if value, ok := blocks[block.Name]; ok && !value.IsNull() {
+		return diags
+	}
+
+	for _, property := range block.Properties {
+		value, configured := properties[property.Key]
+		if configured && !value.IsNull() && !value.IsUnknown() && len(property.OneOf) > 0 {
+			if v := value.ValueString(); !strings.Contains(v, "#{") && !slices.Contains(property.OneOf, v) {
+				diags.AddAttributeError(
+					path.Root("execution_properties").AtMapKey(property.Key),
+					"Invalid execution property value",
+					fmt.Sprintf("The value of %q must be one of %q, but is %q.", property.Key, property.OneOf, v),
+				)
+			}
+		}
+
+		if !configured && isProcessStepPropertyRequired(block, property, properties) {
+			diags.AddAttributeError(
+				path.Root("execution_properties"),
+				"Missing execution property",
+				fmt.Sprintf("%s steps require the execution property %q. Set it in execution_properties or set the %q attribute of the %q block.", actionType.ValueString(), property.Key, property.Attribute, block.Name),
+			)
+		}
+	}
+
+	return diags
+}
+
+func isProcessStepPropertyRequired(block schemas.ProcessStepActionBlock, property schemas.ProcessStepActionProperty, properties map[string]types.String) bool {
+	condition := property.RequiredWhen
+	if condition == nil {
+		return property.Required
+	}
+
+	conditionProperty, ok := block.PropertyByAttribute(condition.Attribute)
+	if !ok {
+		return false
+	}
+
+	conditionValue := conditionProperty.Default
+	if value, configured := properties[conditionProperty.Key]; configured {
+		if value.IsUnknown() {
+			return false
+		}
+		conditionValue = value.ValueString()
+	}
+	return slices.Contains(condition.Values, conditionValue)
+}
@@ -0,0 +1,54 @@
+package octopusdeploy_framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateProcessStepExecutionProperties(t *testing.T) {
+	properties := func(values map[string]string) types.Map {
+		elements := make(map[string]attr.Value, len(values))
+		for key, value := range values {
+			elements[key] = types.StringValue(value)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+
+	diags := validateProcessStepExecutionProperties(types.StringValue("Octopus.Script"), properties(map[string]string{
+		"Octopus.Action.RunOnServer":             "True",
+		"Octopus.Action.Script.Syntax":           "Bash",
+		"Octopus.Action.Script.ScriptBody":       "echo",
+		"Octopus.Action.CustomScripts.PreDeploy": "echo",
+		"Custom.Property":                        "value",
+	}), nil)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 0, diags.WarningsCount())
+
+	diags = validateProcessStepExecutionProperties(types.StringValue("Octopus.Script"), properties(map[string]string{
+		"Octopus.Action.Script.Syntax":     "Powershell",
+		"Octopus.Action.Script.ScriptBdy":  "echo",
+		"Octopus.Action.Script.Unheard.Of": "value",
+	}), nil)
+	require.Equal(t, 3, diags.ErrorsCount())
+	assert.Equal(t, `"Octopus.Action.Script.ScriptBdy" is not an execution property of Octopus.Script steps. Did you mean "Octopus.Action.Script.ScriptBody"?`, diags.Errors()[0].Detail())
+	assert.Contains(t, diags.Errors()[1].Detail(), `The value of "Octopus.Action.Script.Syntax" must be one of`)
+	assert.Contains(t, diags.Errors()[2].Detail(), `Octopus.Script steps require the execution property "Octopus.Action.Script.ScriptBody"`)
+	require.Equal(t, 1, diags.WarningsCount())
+	assert.Contains(t, diags.Warnings()[0].Detail(), `"Octopus.Action.Script.Unheard.Of" is not a known execution property of Octopus.Script steps`)
+
+	diags = validateProcessStepExecutionProperties(types.StringValue("Octopus.Script"), properties(map[string]string{
+		"Octopus.Action.Script.ScriptSource":   "Package",
+		"Octopus.Action.Script.ScriptFileName": "deploy.sh",
+	}), nil)
+	assert.False(t, diags.HasError(), "the script body is only required by inline scripts")
+
+	diags = validateProcessStepExecutionProperties(types.StringValue("Octopus.AzurePowerShell"), properties(map[string]string{
+		"Octopus.Action.Anything": "value",
+	}), nil)
+	assert.False(t, diags.HasError(), "action types that are not in the catalogue are not validated")
+	assert.Equal(t, 0, diags.WarningsCount())
+}
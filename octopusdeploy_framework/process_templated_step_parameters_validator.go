@@ -0,0 +1,171 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// loadPlannedTemplatedStepTemplate loads the template version planned for a templated step, or returns nil when the
+// template or its version is not known yet.
+func loadPlannedTemplatedStepTemplate(ctx context.Context, client *client.Client, plan tfsdk.Plan) (*actiontemplates.ActionTemplate, diag.Diagnostics) {
+	var spaceId types.String
+	var templateId types.String
+	var templateVersion types.Int32
+	diags := plan.GetAttribute(ctx, path.Root("space_id"), &spaceId)
+	diags.Append(plan.GetAttribute(ctx, path.Root("template_id"), &templateId)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("template_version"), &templateVersion)...)
+	if diags.HasError() || templateId.IsUnknown() || templateVersion.IsUnknown() || templateVersion.IsNull() {
+		return nil, diags
+	}
+
+	// The client's space is used until the space of a new step is known
+	return loadActionTemplate(client, spaceId.ValueString(), templateId.ValueString(), templateVersion.ValueInt32())
+}
+
+// validateTemplatedStepParameters checks the parameters of a templated step against the parameter definitions of its
+// template: parameters must be declared by the template, and the values of select and checkbox parameters must be one
+// of their options. Parameters without a value or default value are reported as warnings, because the template
+// receives an empty value for them.
+func validateTemplatedStepParameters(ctx context.Context, template *actiontemplates.ActionTemplate, parameters types.Map) diag.Diagnostics {
+	if parameters.IsNull() || parameters.IsUnknown() {
+		return diag.Diagnostics{}
+	}
+
+	configuredParameters := make(map[string]types.String, len(parameters.Elements()))
+	diags := parameters.ElementsAs(ctx, &configuredParameters, false)
+	if diags.HasError() {
+		return diags
+	}
+
+	declared := make(map[string]*actiontemplates.ActionTemplateParameter, len(template.Parameters))
+	for i := range template.Parameters {
+		declared[template.Parameters[i].Name] = &template.Parameters[i]
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(configuredParameters)) {
+		parameter, ok := declared[name]
+		if !ok {
+			detail := fmt.Sprintf("Parameter '%s' is not declared by version %d of template '%s'.", name, template.Version, template.Name)
+			if suggestion := closestName(name, slices.Sorted(maps.Keys(declared))); suggestion != "" {
+				detail += fmt.Sprintf(" Did you mean '%s'?", suggestion)
+			}
+			diags.AddAttributeError(path.Root("parameters").AtMapKey(name), "Unknown template parameter", detail)
+			continue
+		}
+
+		value := configuredParameters[name]
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if err := validateTemplateParameterValue(parameter, value.ValueString()); err != "" {
+			diags.AddAttributeError(path.Root("parameters").AtMapKey(name), "Invalid template parameter value", err)
+		}
+	}
+
+	for _, parameter := range template.Parameters {
+		if _, configured := configuredParameters[parameter.Name]; configured || hasTemplateParameterDefaultValue(&parameter) {
+			continue
+		}
+		diags.AddAttributeWarning(
+			path.Root("parameters"),
+			"Missing template parameter",
+			fmt.Sprintf("Parameter '%s' of template '%s' has no default value and is not set, so the step receives an empty value.", parameter.Name, template.Name),
+		)
+	}
+
+	return diags
+}
+
+func hasTemplateParameterDefaultValue(parameter *actiontemplates.ActionTemplateParameter) bool {
+	defaultValue := parameter.DefaultValue
+	if defaultValue == nil {
+		return false
+	}
+	if defaultValue.IsSensitive {
+		return defaultValue.SensitiveValue != nil && defaultValue.SensitiveValue.HasValue
+	}
+	return defaultValue.Value != ""
+}
+
+// validateTemplateParameterValue returns why a value is not valid for the control type of a parameter, or an empty
+// string when it is valid. Values with variable expressions are only known during the deployment and are not checked.
+func validateTemplateParameterValue(parameter *actiontemplates.ActionTemplateParameter, value string) string {
+	if value == "" || strings.Contains(value, "#{") {
+		return ""
+	}
+
+	switch parameter.DisplaySettings["Octopus.ControlType"] {
+	case "Select":
+		options := templateParameterSelectOptions(parameter.DisplaySettings["Octopus.SelectOptions"])
+		if len(options) > 0 && !slices.Contains(options, value) {
+			return fmt.Sprintf("The value of parameter '%s' must be one of '%s', but is '%s'.", parameter.Name, strings.Join(options, "', '"), value)
+		}
+	case "Checkbox":
+		if !isBooleanLikeString(value) {
+			return fmt.Sprintf("The value of checkbox parameter '%s' must be 'True' or 'False', but is '%s'.", parameter.Name, value)
+		}
+	}
+	return ""
+}
+
+// templateParameterSelectOptions returns the values of select options, which are declared one per line as value|label.
+func templateParameterSelectOptions(selectOptions string) []string {
+	var options []string
+	for _, line := range strings.Split(strings.ReplaceAll(selectOptions, "\r\n", "\n"), "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		value, _, _ := strings.Cut(line, "|")
+		options = append(options, value)
+	}
+	return options
+}
+
+// closestName returns the candidate that a name is most likely a misspelling of, or an empty string when no candidate
+// is close enough.
+func closestName(name string, candidates []string) string {
+	closest := ""
+	closestDistance := 3 // Names further apart are not considered misspellings
+	for _, candidate := range candidates {
+		if strings.EqualFold(name, candidate) {
+			return candidate
+		}
+		if distance := editDistance(strings.ToLower(name), strings.ToLower(candidate)); distance < closestDistance {
+			closest, closestDistance = candidate, distance
+		}
+	}
+	return closest
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a string, b string) int {
+	source, target := []rune(a), []rune(b)
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(target)]
+}
@@ -0,0 +1,289 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/libraryvariablesets"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Prefixes of the variables that Octopus Deploy, Tentacle and Calamari provide during a deployment or runbook run, and
+// of environment variables
+var systemVariablePrefixes = []string{"octopus.", "env:", "tentacle.", "calamari."}
+
+// variableDefinitions are the names of the variables that the expressions of a process can reference: the variables of
+// the project and of the library variable sets it includes, and the names of the project and common tenant templates.
+type variableDefinitions map[string]string
+
+func newVariableDefinitions(names ...string) variableDefinitions {
+	definitions := variableDefinitions{}
+	definitions.add(names...)
+	return definitions
+}
+
+// add adds names to the definitions. Variable names are not case-sensitive.
+func (d variableDefinitions) add(names ...string) {
+	for _, name := range names {
+		if name != "" {
+			d[strings.ToLower(name)] = name
+		}
+	}
+}
+
+func (d variableDefinitions) addTemplates(templates []actiontemplates.ActionTemplateParameter) {
+	for _, template := range templates {
+		d.add(template.Name)
+	}
+}
+
+// defines returns whether a reference is a system variable or a defined variable. A reference to an element of an
+// indexed variable (Name[Index].Property) is defined by any variable of the collection.
+func (d variableDefinitions) defines(reference string) bool {
+	name := strings.ToLower(reference)
+	if slices.ContainsFunc(systemVariablePrefixes, func(prefix string) bool { return strings.HasPrefix(name, prefix) }) {
+		return true
+	}
+	if _, ok := d[name]; ok {
+		return true
+	}
+
+	collection, _, indexed := strings.Cut(name, "[")
+	for defined := range d {
+		if indexed && strings.HasPrefix(defined, collection+"[") {
+			return true
+		}
+		if strings.HasPrefix(defined, name+".") || strings.HasPrefix(defined, name+"[") {
+			return true // Each iterates over the elements of a collection, e.g. #{each x in Name}
+		}
+	}
+	return false
+}
+
+// names returns the defined names in their original case.
+func (d variableDefinitions) names() []string {
+	return slices.Sorted(maps.Values(d))
+}
+
+// loadProcessVariableDefinitions loads the variable definitions of the project that owns a deployment or runbook
+// process. The definitions are nil when the project can't be loaded, references are not analysed then.
+func loadProcessVariableDefinitions(ctx context.Context, config *Config, spaceID string, processID string) variableDefinitions {
+	switch kind, ownerID := deconstructProcessIdentifier(processID); kind {
+	case "deployment":
+		return loadProjectVariableDefinitions(ctx, config, spaceID, ownerID)
+	case "runbook":
+		runbook, err := runbooks.GetByID(config.Client, spaceID, ownerID)
+		if err != nil {
+			tflog.Debug(ctx, "Unable to load runbook to analyse variable references", map[string]interface{}{"runbook_id": ownerID, "error": err.Error()})
+			return nil
+		}
+		return loadProjectVariableDefinitions(ctx, config, spaceID, runbook.ProjectID)
+	default:
+		return nil
+	}
+}
+
+// loadProjectVariableDefinitions loads the variable definitions of a project. The definitions are loaded once per
+// project for a plan, as every step of a process is analysed against the same definitions.
+func loadProjectVariableDefinitions(ctx context.Context, config *Config, spaceID string, projectID string) variableDefinitions {
+	if cached, ok := config.variableDefinitions.Load(projectID); ok {
+		return cached.(variableDefinitions)
+	}
+
+	project, err := projects.GetByID(config.Client, spaceID, projectID)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to load project to analyse variable references", map[string]interface{}{"project_id": projectID, "error": err.Error()})
+		return nil
+	}
+
+	definitions := newVariableDefinitions()
+	definitions.addTemplates(project.Templates)
+	if err := addVariableSetDefinitions(config.Client, spaceID, project.VariableSetID, definitions); err != nil {
+		tflog.Debug(ctx, "Unable to load project variables to analyse variable references", map[string]interface{}{"project_id": projectID, "error": err.Error()})
+		return nil
+	}
+	if err := addLibraryVariableSetDefinitions(config.Client, spaceID, project.IncludedLibraryVariableSets, definitions); err != nil {
+		tflog.Debug(ctx, "Unable to load library variable sets to analyse variable references", map[string]interface{}{"project_id": projectID, "error": err.Error()})
+		return nil
+	}
+
+	config.variableDefinitions.Store(projectID, definitions)
+	return definitions
+}
+
+func addVariableSetDefinitions(client *client.Client, spaceID string, variableSetID string, definitions variableDefinitions) error {
+	if variableSetID == "" {
+		return nil
+	}
+
+	variableSet, err := variables.GetVariableSet(client, spaceID, variableSetID)
+	if err != nil {
+		return err
+	}
+	for _, variable := range variableSet.Variables {
+		definitions.add(variable.Name)
+	}
+	return nil
+}
+
+func addLibraryVariableSetDefinitions(client *client.Client, spaceID string, libraryVariableSetIDs []string, definitions variableDefinitions) error {
+	for _, id := range libraryVariableSetIDs {
+		libraryVariableSet, err := libraryvariablesets.GetByID(client, spaceID, id)
+		if err != nil {
+			return err
+		}
+
+		definitions.addTemplates(libraryVariableSet.Templates)
+		if err := addVariableSetDefinitions(client, spaceID, libraryVariableSet.VariableSetID, definitions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// warnAboutUndefinedVariableReferences adds a warning for each variable that an expression of a value references and
+// that is not defined. Octopus Deploy leaves an expression that references an undefined variable in the output as is.
+func warnAboutUndefinedVariableReferences(definitions variableDefinitions, attributePath path.Path, value types.String) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if definitions == nil || value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+
+	for _, reference := range octostacheReferences(value.ValueString()) {
+		if definitions.defines(reference) {
+			continue
+		}
+
+		detail := fmt.Sprintf("The variable %q is not defined in the project, its included library variable sets or its tenant templates, and is not an Octopus system variable.", reference)
+		if suggestion := closestName(reference, definitions.names()); suggestion != "" {
+			detail += fmt.Sprintf(" Did you mean %q?", suggestion)
+		}
+		detail += " The expression is not substituted during a deployment unless the variable is created before it runs, e.g. in the same apply."
+		diags.AddAttributeWarning(attributePath, "Undefined variable reference", detail)
+	}
+	return diags
+}
+
+// warnAboutUndefinedVariableReferencesInMap analyses the values of a map of strings, e.g. the execution properties of
+// a step.
+func warnAboutUndefinedVariableReferencesInMap(definitions variableDefinitions, attributePath path.Path, values types.Map) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if definitions == nil || values.IsNull() || values.IsUnknown() {
+		return diags
+	}
+
+	elements := values.Elements()
+	for _, key := range slices.Sorted(maps.Keys(elements)) {
+		if value, ok := elements[key].(types.String); ok {
+			diags.Append(warnAboutUndefinedVariableReferences(definitions, attributePath.AtMapKey(key), value)...)
+		}
+	}
+	return diags
+}
+
+// warnAboutUndefinedVariableReferencesInObject analyses the string attributes of an object, e.g. a typed action block
+// of a step.
+func warnAboutUndefinedVariableReferencesInObject(definitions variableDefinitions, attributePath path.Path, object types.Object) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if definitions == nil || object.IsNull() || object.IsUnknown() {
+		return diags
+	}
+
+	attributes := object.Attributes()
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		if value, ok := attributes[name].(types.String); ok {
+			diags.Append(warnAboutUndefinedVariableReferences(definitions, attributePath.AtName(name), value)...)
+		}
+	}
+	return diags
+}
+
+// warnAboutUndefinedTemplatedStepVariableReferences analyses the parameter values of a planned templated step. The
+// parameters of the step template are variables of the step, so the values can reference each other.
+func warnAboutUndefinedTemplatedStepVariableReferences(ctx context.Context, config *Config, plan tfsdk.Plan, template *actiontemplates.ActionTemplate) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	var spaceID, processID types.String
+	var parameters types.Map
+	diags.Append(plan.GetAttribute(ctx, path.Root("space_id"), &spaceID)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("process_id"), &processID)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	if diags.HasError() || processID.IsUnknown() || template == nil {
+		return diags // The parameters are known when the template is loaded
+	}
+
+	projectDefinitions := loadProcessVariableDefinitions(ctx, config, spaceID.ValueString(), processID.ValueString())
+	if projectDefinitions == nil {
+		return diags
+	}
+
+	definitions := maps.Clone(projectDefinitions)
+	definitions.addTemplates(template.Parameters)
+	diags.Append(warnAboutUndefinedVariableReferencesInMap(definitions, path.Root("parameters"), parameters)...)
+	return diags
+}
+
+// warnAboutUndefinedReleaseNotesVariableReferences analyses the release notes template against the planned variable
+// definitions of the project: its variables, the planned library variable sets and the planned project templates.
+func warnAboutUndefinedReleaseNotesVariableReferences(ctx context.Context, config *Config, plan tfsdk.Plan) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	var spaceID, variableSetID, releaseNotesTemplate types.String
+	var includedLibraryVariableSets types.Set
+	diags.Append(plan.GetAttribute(ctx, path.Root("space_id"), &spaceID)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("variable_set_id"), &variableSetID)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("release_notes_template"), &releaseNotesTemplate)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("included_library_variable_sets"), &includedLibraryVariableSets)...)
+	if diags.HasError() || releaseNotesTemplate.IsNull() || releaseNotesTemplate.IsUnknown() || !strings.Contains(releaseNotesTemplate.ValueString(), "#{") {
+		return diags
+	}
+	if includedLibraryVariableSets.IsUnknown() {
+		return diags // The library variable sets are known at apply
+	}
+
+	var templates types.List
+	diags.Append(plan.GetAttribute(ctx, path.Root("template"), &templates)...)
+	if diags.HasError() || templates.IsUnknown() {
+		return diags
+	}
+
+	definitions := newVariableDefinitions()
+	for _, element := range templates.Elements() {
+		if template, ok := element.(types.Object); ok {
+			if name, ok := template.Attributes()["name"].(types.String); ok {
+				definitions.add(name.ValueString())
+			}
+		}
+	}
+
+	libraryVariableSetIDs := make([]string, 0, len(includedLibraryVariableSets.Elements()))
+	for _, element := range includedLibraryVariableSets.Elements() {
+		if id, ok := element.(types.String); ok && !id.IsUnknown() && !id.IsNull() {
+			libraryVariableSetIDs = append(libraryVariableSetIDs, id.ValueString())
+		}
+	}
+
+	err := addLibraryVariableSetDefinitions(config.Client, spaceID.ValueString(), libraryVariableSetIDs, definitions)
+	if err == nil && !variableSetID.IsUnknown() {
+		err = addVariableSetDefinitions(config.Client, spaceID.ValueString(), variableSetID.ValueString(), definitions)
+	}
+	if err != nil {
+		tflog.Debug(ctx, "Unable to load variables to analyse the release notes template", map[string]interface{}{"error": err.Error()})
+		return diags
+	}
+
+	diags.Append(warnAboutUndefinedVariableReferences(definitions, path.Root("release_notes_template"), releaseNotesTemplate)...)
+	return diags
+}
@@ -0,0 +1,54 @@
+package octopusdeploy_framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariableDefinitionsDefines(t *testing.T) {
+	definitions := newVariableDefinitions("Greeting", "Servers[Web].Port", "Database.Name")
+
+	require.True(t, definitions.defines("Greeting"))
+	require.True(t, definitions.defines("greeting"), "variable names are not case-sensitive")
+	require.True(t, definitions.defines("Octopus.Environment.Name"))
+	require.True(t, definitions.defines("env:PATH"))
+	require.True(t, definitions.defines("Servers[Api].Port"), "any element of an indexed variable is defined")
+	require.True(t, definitions.defines("Servers"), "the collection of an indexed variable is defined")
+	require.True(t, definitions.defines("Database"))
+	require.False(t, definitions.defines("Greetings"))
+	require.False(t, definitions.defines("Data"))
+}
+
+func TestWarnAboutUndefinedVariableReferences(t *testing.T) {
+	definitions := newVariableDefinitions("Greeting", "Name")
+	attributePath := path.Root("execution_properties").AtMapKey("Octopus.Action.Script.ScriptBody")
+
+	diags := warnAboutUndefinedVariableReferences(definitions, attributePath, types.StringValue("Write-Host '#{Greting}, #{Name} #{Octopus.Machine.Name} #{Unknown} #{Unknown}'"))
+	require.False(t, diags.HasError())
+	require.Len(t, diags, 2)
+	require.Equal(t, "Undefined variable reference", diags[0].Summary())
+	require.Contains(t, diags[0].Detail(), `"Greting"`)
+	require.Contains(t, diags[0].Detail(), `Did you mean "Greeting"?`)
+	require.Contains(t, diags[1].Detail(), `"Unknown"`)
+	require.NotContains(t, diags[1].Detail(), "Did you mean")
+
+	require.Empty(t, warnAboutUndefinedVariableReferences(nil, attributePath, types.StringValue("#{Unknown}")), "references are not analysed without definitions")
+	require.Empty(t, warnAboutUndefinedVariableReferences(definitions, attributePath, types.StringUnknown()))
+}
+
+func TestWarnAboutUndefinedVariableReferencesInMap(t *testing.T) {
+	definitions := newVariableDefinitions("Defined")
+	values := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Second": types.StringValue("#{Undefined}"),
+		"First":  types.StringValue("#{Defined} #{Missing}"),
+	})
+
+	diags := warnAboutUndefinedVariableReferencesInMap(definitions, path.Root("execution_properties"), values)
+	require.Len(t, diags, 2)
+	require.Contains(t, diags[0].Detail(), `"Missing"`)
+	require.Contains(t, diags[1].Detail(), `"Undefined"`)
+}
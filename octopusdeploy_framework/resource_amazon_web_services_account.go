@@ -31,8 +31,8 @@ func (r *amazonWebServicesAccountResource) Schema(ctx context.Context, req resou
 	resp.Schema = schemas.AmazonWebServicesAccountSchema{}.GetResourceSchema()
 }
 
-func (r *amazonWebServicesAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *amazonWebServicesAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *amazonWebServicesAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -35,8 +35,8 @@ func (r *artifactoryGenericFeedTypeResource) Schema(ctx context.Context, req res
 	resp.Schema = schemas.ArtifactoryGenericFeedSchema{}.GetResourceSchema()
 }
 
-func (r *artifactoryGenericFeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *artifactoryGenericFeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *artifactoryGenericFeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -36,8 +36,8 @@ func (r *awsElasticContainerRegistryFeedTypeResource) Schema(ctx context.Context
 	resp.Schema = schemas.AwsElasticContainerRegistrySchema{}.GetResourceSchema()
 }
 
-func (r *awsElasticContainerRegistryFeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *awsElasticContainerRegistryFeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *awsElasticContainerRegistryFeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -34,8 +34,8 @@ func (r *azureContainerRegistryFeedTypeResource) Schema(ctx context.Context, req
 	resp.Schema = schemas.AzureContainerRegistryFeedSchema{}.GetResourceSchema()
 }
 
-func (r *azureContainerRegistryFeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *azureContainerRegistryFeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *azureContainerRegistryFeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
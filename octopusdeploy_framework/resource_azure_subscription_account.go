@@ -32,8 +32,8 @@ func (r *azureSubscriptionAccountResource) Schema(ctx context.Context, req resou
 	resp.Schema = schemas.AzureSubscriptionAccountSchema{}.GetResourceSchema()
 }
 
-func (r *azureSubscriptionAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *azureSubscriptionAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *azureSubscriptionAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
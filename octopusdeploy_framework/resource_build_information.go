@@ -0,0 +1,338 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/buildinformation"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &buildInformationResource{}
+	_ resource.ResourceWithImportState = &buildInformationResource{}
+)
+
+// createdBuildInformationPrivateStateKey is the private state key that marks build information which this resource
+// created, overwrote or imported, and so may overwrite and delete. Build information that the server kept because the
+// overwrite mode is IgnoreIfExists belongs to whoever pushed it.
+const createdBuildInformationPrivateStateKey = "created"
+
+type buildInformationResource struct {
+	*Config
+}
+
+func NewBuildInformationResource() resource.Resource {
+	return &buildInformationResource{}
+}
+
+func (r *buildInformationResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.BuildInformationResourceName)
+}
+
+func (r *buildInformationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.BuildInformationSchema{}.GetResourceSchema()
+}
+
+func (r *buildInformationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
+}
+
+func (r *buildInformationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan schemas.BuildInformationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, resp.Private, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *buildInformationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schemas.BuildInformationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported build information is in the space of the provider
+	if state.SpaceID.ValueString() == "" {
+		state.SpaceID = types.StringValue(r.Client.GetSpaceID())
+	}
+	if state.OverwriteMode.IsNull() {
+		state.OverwriteMode = types.StringValue(string(buildinformation.OverwriteModeFailIfExists))
+	}
+
+	buildInformation, err := buildinformation.GetById(r.Client, state.SpaceID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if err := errors.ProcessApiErrorV2(ctx, resp, &state, err, "build information"); err != nil {
+			resp.Diagnostics.AddError("Error reading build information", err.Error())
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(setBuildInformation(ctx, &state, buildInformation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update overwrites the build information that this resource created, as the server keeps a single record for each
+// package version. Build information that the server kept instead is pushed again with the configured overwrite mode.
+func (r *buildInformationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan schemas.BuildInformationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, resp.Private, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *buildInformationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state schemas.BuildInformationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, diags := buildInformationCreated(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !created {
+		tflog.Debug(ctx, "Keeping build information that this resource didn't create", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		return
+	}
+
+	if err := buildinformation.DeleteByID(r.Client, state.SpaceID.ValueString(), state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting build information", err.Error())
+	}
+}
+
+// ImportState imports the build information as if this resource created it, so that it's overwritten and deleted.
+func (r *buildInformationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(setBuildInformationCreated(ctx, resp.Private, true)...)
+}
+
+// apply pushes the build information and records whether this resource created it. An update overwrites build
+// information that this resource created and pushes any other build information with the configured overwrite mode.
+func (r *buildInformationResource) apply(ctx context.Context, model *schemas.BuildInformationResourceModel, private privateState, update bool) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	overwriteMode := buildinformation.OverwriteMode(model.OverwriteMode.ValueString())
+	if update {
+		created, createdDiags := buildInformationCreated(ctx, private)
+		diags.Append(createdDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		if created {
+			overwriteMode = buildinformation.OverwriteModeOverwriteExisting
+		}
+	}
+
+	created := r.push(ctx, model, overwriteMode, &diags)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(setBuildInformationCreated(ctx, private, created)...)
+	return diags
+}
+
+// push sends the build information to the server and sets the computed attributes of the model from the response. It
+// reports whether the server stored the pushed build information. The configured attributes are kept when the server
+// kept different build information because the overwrite mode is IgnoreIfExists, so Read shows the difference.
+func (r *buildInformationResource) push(ctx context.Context, model *schemas.BuildInformationResourceModel, overwriteMode buildinformation.OverwriteMode, diags *diag.Diagnostics) bool {
+	spaceID := model.SpaceID.ValueString()
+	if spaceID == "" {
+		spaceID = r.Client.GetSpaceID()
+	}
+
+	command, commandDiags := expandBuildInformation(ctx, spaceID, model, overwriteMode)
+	diags.Append(commandDiags...)
+	if diags.HasError() {
+		return false
+	}
+
+	tflog.Debug(ctx, "Pushing build information", map[string]interface{}{
+		"package_id":     command.PackageId,
+		"version":        command.Version,
+		"overwrite_mode": command.OverwriteMode,
+	})
+
+	pushed, err := pushBuildInformation(r.Client, command)
+	if err != nil {
+		diags.AddError("Error pushing build information", err.Error())
+		return false
+	}
+
+	model.ID = types.StringValue(pushed.GetID())
+	model.SpaceID = types.StringValue(spaceID)
+	diags.Append(setBuildInformationComputed(ctx, model, pushed)...)
+
+	if overwriteMode == buildinformation.OverwriteModeIgnoreIfExists && !buildInformationMatches(command, pushed) {
+		diags.AddWarning("Build information already exists", fmt.Sprintf("Version %s of package %s already has different build information, which was kept because the overwrite mode is %s. This resource doesn't overwrite or delete it, and the next plan shows the difference.", command.Version, command.PackageId, overwriteMode))
+		return false
+	}
+	return true
+}
+
+// buildInformationMatches reports whether the server stored the build information of a command.
+func buildInformationMatches(command *buildinformation.CreateBuildInformationCommand, buildInformation *buildinformation.BuildInformation) bool {
+	pushed := command.OctopusBuildInformation
+	if pushed.BuildEnvironment != buildInformation.BuildEnvironment ||
+		pushed.BuildNumber != buildInformation.BuildNumber ||
+		pushed.BuildUrl != buildInformation.BuildURL ||
+		pushed.Branch != buildInformation.Branch ||
+		pushed.VcsType != buildInformation.VcsType ||
+		pushed.VcsRoot != buildInformation.VcsRoot ||
+		pushed.VcsCommitNumber != buildInformation.VcsCommitNumber ||
+		len(pushed.Commits) != len(buildInformation.Commits) {
+		return false
+	}
+
+	for i, commit := range pushed.Commits {
+		if commit.Id != buildInformation.Commits[i].ID || commit.Comment != buildInformation.Commits[i].Comment {
+			return false
+		}
+	}
+	return true
+}
+
+func setBuildInformationCreated(ctx context.Context, private privateState, created bool) diag.Diagnostics {
+	if !created {
+		return private.SetKey(ctx, createdBuildInformationPrivateStateKey, nil)
+	}
+
+	value, err := json.Marshal(created)
+	if err != nil {
+		diags := diag.Diagnostics{}
+		diags.AddError("Unable to save whether this resource created the build information", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, createdBuildInformationPrivateStateKey, value)
+}
+
+func buildInformationCreated(ctx context.Context, private privateState) (bool, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, createdBuildInformationPrivateStateKey)
+	if diags.HasError() || value == nil {
+		return false, diags
+	}
+
+	var created bool
+	if err := json.Unmarshal(value, &created); err != nil {
+		diags.AddError("Unable to read whether this resource created the build information", err.Error())
+		return false, diags
+	}
+	return created, diags
+}
+
+// pushBuildInformation creates or overwrites the build information of a package version. The SDK doesn't pass the
+// overwrite mode in the query string, which is where the server reads it from.
+func pushBuildInformation(octopus *client.Client, command *buildinformation.CreateBuildInformationCommand) (*buildinformation.BuildInformation, error) {
+	parameters := url.Values{"overwriteMode": {string(command.OverwriteMode)}}
+	return newclient.Post[buildinformation.BuildInformation](octopus.HttpSession(), fmt.Sprintf("/api/%s/build-information?%s", command.SpaceId, parameters.Encode()), command)
+}
+
+func expandBuildInformation(ctx context.Context, spaceID string, model *schemas.BuildInformationResourceModel, overwriteMode buildinformation.OverwriteMode) (*buildinformation.CreateBuildInformationCommand, diag.Diagnostics) {
+	var commitModels []schemas.BuildInformationCommitModel
+	diags := model.Commits.ElementsAs(ctx, &commitModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	commits := make([]*buildinformation.Commit, 0, len(commitModels))
+	for _, commit := range commitModels {
+		commits = append(commits, &buildinformation.Commit{Id: commit.ID.ValueString(), Comment: commit.Comment.ValueString()})
+	}
+
+	command := buildinformation.NewCreateBuildInformationCommand(spaceID, model.PackageID.ValueString(), model.Version.ValueString(), buildinformation.OctopusBuildInformation{
+		BuildEnvironment: model.BuildEnvironment.ValueString(),
+		BuildNumber:      model.BuildNumber.ValueString(),
+		BuildUrl:         model.BuildURL.ValueString(),
+		Branch:           model.Branch.ValueString(),
+		VcsType:          model.VcsType.ValueString(),
+		VcsRoot:          model.VcsRoot.ValueString(),
+		VcsCommitNumber:  model.VcsCommitNumber.ValueString(),
+		Commits:          commits,
+	})
+	command.OverwriteMode = overwriteMode
+	return command, diags
+}
+
+func setBuildInformation(ctx context.Context, model *schemas.BuildInformationResourceModel, buildInformation *buildinformation.BuildInformation) diag.Diagnostics {
+	model.PackageID = types.StringValue(buildInformation.PackageID)
+	model.Version = types.StringValue(buildInformation.Version)
+	model.BuildEnvironment = util.StringOrNull(buildInformation.BuildEnvironment)
+	model.BuildNumber = util.StringOrNull(buildInformation.BuildNumber)
+	model.BuildURL = util.StringOrNull(buildInformation.BuildURL)
+	model.Branch = util.StringOrNull(buildInformation.Branch)
+	model.VcsType = util.StringOrNull(buildInformation.VcsType)
+	model.VcsRoot = util.StringOrNull(buildInformation.VcsRoot)
+	model.VcsCommitNumber = util.StringOrNull(buildInformation.VcsCommitNumber)
+
+	// An empty list of commits reads back as no commits, so keep whichever the configuration used
+	if len(buildInformation.Commits) > 0 || !model.Commits.IsNull() {
+		commits := make([]schemas.BuildInformationCommitModel, 0, len(buildInformation.Commits))
+		for _, commit := range buildInformation.Commits {
+			commits = append(commits, schemas.BuildInformationCommitModel{
+				ID:      types.StringValue(commit.ID),
+				Comment: util.StringOrNull(commit.Comment),
+			})
+		}
+		commitList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: schemas.BuildInformationCommitObjectType()}, commits)
+		if diags.HasError() {
+			return diags
+		}
+		model.Commits = commitList
+	}
+
+	return setBuildInformationComputed(ctx, model, buildInformation)
+}
+
+func setBuildInformationComputed(ctx context.Context, model *schemas.BuildInformationResourceModel, buildInformation *buildinformation.BuildInformation) diag.Diagnostics {
+	model.VcsCommitURL = types.StringValue(buildInformation.VcsCommitURL)
+	model.IssueTrackerName = types.StringValue(buildInformation.IssueTrackerName)
+
+	workItems := make([]schemas.BuildInformationWorkItemModel, 0, len(buildInformation.WorkItems))
+	for _, workItem := range buildInformation.WorkItems {
+		workItems = append(workItems, schemas.BuildInformationWorkItemModel{
+			ID:          types.StringValue(workItem.ID),
+			Description: types.StringValue(workItem.Description),
+			LinkURL:     types.StringValue(workItem.LinkURL),
+			Source:      types.StringValue(workItem.Source),
+		})
+	}
+	workItemList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: schemas.BuildInformationWorkItemObjectType()}, workItems)
+	model.WorkItems = workItemList
+	return diags
+}
@@ -0,0 +1,221 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/buildinformation"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandBuildInformation(t *testing.T) {
+	ctx := context.Background()
+	commits, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: schemas.BuildInformationCommitObjectType()}, []schemas.BuildInformationCommitModel{
+		{ID: types.StringValue("a1b2c3"), Comment: types.StringValue("Fix login, closes #12")},
+	})
+	require.False(t, diags.HasError())
+
+	command, diags := expandBuildInformation(ctx, "Spaces-1", &schemas.BuildInformationResourceModel{
+		PackageID:        types.StringValue("web"),
+		Version:          types.StringValue("1.2.3"),
+		BuildEnvironment: types.StringValue("GitHub Actions"),
+		BuildNumber:      types.StringValue("42"),
+		Branch:           types.StringValue("main"),
+		VcsType:          types.StringValue("Git"),
+		Commits:          commits,
+	}, buildinformation.OverwriteModeIgnoreIfExists)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "Spaces-1", command.SpaceId)
+	assert.Equal(t, "web", command.PackageId)
+	assert.Equal(t, "1.2.3", command.Version)
+	assert.Equal(t, buildinformation.OverwriteModeIgnoreIfExists, command.OverwriteMode)
+	assert.Equal(t, "GitHub Actions", command.OctopusBuildInformation.BuildEnvironment)
+	assert.Equal(t, "42", command.OctopusBuildInformation.BuildNumber)
+	assert.Equal(t, []*buildinformation.Commit{{Id: "a1b2c3", Comment: "Fix login, closes #12"}}, command.OctopusBuildInformation.Commits)
+
+	command, diags = expandBuildInformation(ctx, "Spaces-1", &schemas.BuildInformationResourceModel{
+		PackageID: types.StringValue("web"),
+		Version:   types.StringValue("1.2.3"),
+		Commits:   types.ListNull(types.ObjectType{AttrTypes: schemas.BuildInformationCommitObjectType()}),
+	}, buildinformation.OverwriteModeFailIfExists)
+	require.False(t, diags.HasError())
+	assert.Empty(t, command.OctopusBuildInformation.Commits)
+}
+
+func TestSetBuildInformation(t *testing.T) {
+	var buildInformation buildinformation.BuildInformation
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"Id": "BuildInformation-1",
+		"PackageId": "web",
+		"Version": "1.2.3",
+		"Branch": "main",
+		"BuildEnvironment": "",
+		"VcsCommitUrl": "https://github.com/acme/web/commit/a1b2c3",
+		"IssueTrackerName": "GitHub",
+		"Commits": [{"Id": "a1b2c3", "Comment": "Fix login, closes #12", "LinkUrl": "https://github.com/acme/web/commit/a1b2c3"}],
+		"WorkItems": [{"Id": "12", "Description": "Login fails", "LinkUrl": "https://github.com/acme/web/issues/12", "Source": "GitHub"}]
+	}`), &buildInformation))
+
+	ctx := context.Background()
+	model := schemas.BuildInformationResourceModel{Commits: types.ListNull(types.ObjectType{AttrTypes: schemas.BuildInformationCommitObjectType()})}
+	require.False(t, setBuildInformation(ctx, &model, &buildInformation).HasError())
+
+	assert.Equal(t, types.StringValue("web"), model.PackageID)
+	assert.Equal(t, types.StringValue("main"), model.Branch)
+	assert.True(t, model.BuildEnvironment.IsNull())
+	assert.Equal(t, types.StringValue("GitHub"), model.IssueTrackerName)
+
+	var commits []schemas.BuildInformationCommitModel
+	require.False(t, model.Commits.ElementsAs(ctx, &commits, false).HasError())
+	assert.Equal(t, []schemas.BuildInformationCommitModel{{ID: types.StringValue("a1b2c3"), Comment: types.StringValue("Fix login, closes #12")}}, commits)
+
+	var workItems []schemas.BuildInformationWorkItemModel
+	require.False(t, model.WorkItems.ElementsAs(ctx, &workItems, false).HasError())
+	require.Len(t, workItems, 1)
+	assert.Equal(t, types.StringValue("https://github.com/acme/web/issues/12"), workItems[0].LinkURL)
+
+	buildInformation.Commits = nil
+	require.False(t, setBuildInformation(ctx, &model, &buildInformation).HasError())
+	assert.False(t, model.Commits.IsNull(), "configured commits read back as an empty list")
+}
+
+func TestBuildInformationMatches(t *testing.T) {
+	command := buildinformation.NewCreateBuildInformationCommand("Spaces-1", "web", "1.2.3", buildinformation.OctopusBuildInformation{
+		BuildNumber: "42",
+		Branch:      "main",
+		Commits:     []*buildinformation.Commit{{Id: "a1b2c3", Comment: "Fix login, closes #12"}},
+	})
+
+	var stored buildinformation.BuildInformation
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"Id": "BuildInformation-1",
+		"PackageId": "web",
+		"Version": "1.2.3",
+		"BuildNumber": "42",
+		"Branch": "main",
+		"VcsCommitUrl": "https://github.com/acme/web/commit/a1b2c3",
+		"Commits": [{"Id": "a1b2c3", "Comment": "Fix login, closes #12", "LinkUrl": "https://github.com/acme/web/commit/a1b2c3"}]
+	}`), &stored))
+	assert.True(t, buildInformationMatches(command, &stored))
+
+	var kept buildinformation.BuildInformation
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"Id": "BuildInformation-1",
+		"PackageId": "web",
+		"Version": "1.2.3",
+		"BuildNumber": "41",
+		"Branch": "main",
+		"Commits": [{"Id": "a1b2c3", "Comment": "Fix login, closes #12"}]
+	}`), &kept))
+	assert.False(t, buildInformationMatches(command, &kept), "the server kept the build information of another build")
+
+	kept.BuildNumber = "42"
+	kept.Commits = nil
+	assert.False(t, buildInformationMatches(command, &kept), "the server kept build information without the commits")
+}
+
+func TestBuildInformationCreated(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+
+	created, diags := buildInformationCreated(ctx, private)
+	require.False(t, diags.HasError())
+	assert.False(t, created, "build information that the server kept isn't overwritten or deleted")
+
+	require.False(t, setBuildInformationCreated(ctx, private, true).HasError())
+	created, diags = buildInformationCreated(ctx, private)
+	require.False(t, diags.HasError())
+	assert.True(t, created)
+
+	require.False(t, setBuildInformationCreated(ctx, private, false).HasError())
+	assert.NotContains(t, private, createdBuildInformationPrivateStateKey)
+}
+
+// newTestOctopusClient connects to a fake Octopus server for Spaces-1, which answers the API roots that the client reads
+// when it connects and passes every other request to a handler.
+func newTestOctopusClient(t *testing.T, handler http.HandlerFunc) *client.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api" || r.URL.Path == "/api/" || r.URL.Path == "/api/Spaces-1" {
+			_, _ = w.Write([]byte(`{"Links": {}}`))
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	apiURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	apiKey, err := client.NewApiKey("API-TESTTESTTESTTESTTESTTESTTESTTEST")
+	require.NoError(t, err)
+	octopus, err := client.NewClientWithCredentials(server.Client(), apiURL, apiKey, "Spaces-1", "TerraformProvider")
+	require.NoError(t, err)
+	return octopus
+}
+
+func TestApplyBuildInformationIgnoredByServer(t *testing.T) {
+	ctx := context.Background()
+	var overwriteModes []string
+	octopus := newTestOctopusClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/Spaces-1/build-information" {
+			http.NotFound(w, r)
+			return
+		}
+		overwriteModes = append(overwriteModes, r.URL.Query().Get("overwriteMode"))
+		_, _ = w.Write([]byte(`{
+			"Id": "BuildInformation-1",
+			"PackageId": "web",
+			"Version": "1.2.3",
+			"BuildNumber": "41",
+			"Branch": "release",
+			"VcsCommitUrl": "https://github.com/acme/web/commit/f00ba4",
+			"IssueTrackerName": "GitHub",
+			"Commits": [{"Id": "f00ba4", "Comment": "Release 1.2.3"}],
+			"WorkItems": []
+		}`))
+	})
+	r := &buildInformationResource{Config: &Config{Client: octopus}}
+
+	commits, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: schemas.BuildInformationCommitObjectType()}, []schemas.BuildInformationCommitModel{
+		{ID: types.StringValue("a1b2c3"), Comment: types.StringValue("Fix login, closes #12")},
+	})
+	require.False(t, diags.HasError())
+	plan := schemas.BuildInformationResourceModel{
+		SpaceID:       types.StringUnknown(),
+		PackageID:     types.StringValue("web"),
+		Version:       types.StringValue("1.2.3"),
+		BuildNumber:   types.StringValue("42"),
+		Branch:        types.StringValue("main"),
+		Commits:       commits,
+		OverwriteMode: types.StringValue(string(buildinformation.OverwriteModeIgnoreIfExists)),
+	}
+	model := plan
+	private := testPrivateState{}
+
+	diags = r.apply(ctx, &model, private, false)
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, diags.Warnings(), 1)
+	assert.Equal(t, "Build information already exists", diags.Warnings()[0].Summary())
+
+	// The state matches the plan, as Terraform requires, and Read shows the build information that the server kept
+	assert.Equal(t, plan.BuildNumber, model.BuildNumber)
+	assert.Equal(t, plan.Branch, model.Branch)
+	assert.True(t, plan.Commits.Equal(model.Commits))
+	assert.Equal(t, "BuildInformation-1", model.ID.ValueString())
+	assert.Equal(t, "Spaces-1", model.SpaceID.ValueString())
+	assert.Equal(t, "https://github.com/acme/web/commit/f00ba4", model.VcsCommitURL.ValueString())
+	assert.NotContains(t, private, createdBuildInformationPrivateStateKey)
+
+	diags = r.apply(ctx, &model, private, true)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{"IgnoreIfExists", "IgnoreIfExists"}, overwriteModes, "build information that the server kept isn't overwritten")
+	assert.NotContains(t, private, createdBuildInformationPrivateStateKey)
+}
@@ -30,8 +30,8 @@ func (r *builtInTriggerResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schemas.BuiltInTriggerSchema{}.GetResourceSchema()
 }
 
-func (r *builtInTriggerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *builtInTriggerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *builtInTriggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
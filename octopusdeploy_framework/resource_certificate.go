@@ -31,8 +31,8 @@ func (r *certificateResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schemas.CertificateSchema{}.GetResourceSchema()
 }
 
-func (r *certificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *certificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *certificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
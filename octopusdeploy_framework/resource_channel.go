@@ -36,8 +36,8 @@ func (r *channelResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schemas.ChannelSchema{}.GetResourceSchema()
 }
 
-func (r *channelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *channelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *channelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -23,6 +23,7 @@ type communityStepTemplateTypeResource struct {
 var (
 	_ resource.ResourceWithImportState    = &communityStepTemplateTypeResource{}
 	_ resource.ResourceWithValidateConfig = &communityStepTemplateTypeResource{}
+	_ resource.ResourceWithModifyPlan     = &communityStepTemplateTypeResource{}
 )
 
 func NewCommunityStepTemplateResource() resource.Resource {
@@ -37,8 +38,8 @@ func (r *communityStepTemplateTypeResource) Schema(_ context.Context, _ resource
 	resp.Schema = schemas.CommunityStepTemplateSchema{}.GetResourceSchema()
 }
 
-func (r *communityStepTemplateTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *communityStepTemplateTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (*communityStepTemplateTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -53,6 +54,53 @@ func (r *communityStepTemplateTypeResource) ValidateConfig(ctx context.Context,
 	}
 }
 
+// ModifyPlan plans the upgrade of a step template installed from a catalogue when the version of the template in the
+// catalogue is different from the installed version.
+func (r *communityStepTemplateTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan schemas.StepTemplateFromCommunityStepTemplateTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.CatalogueSource.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("catalogue_version"), types.Int32Null())...)
+		return
+	}
+
+	if plan.CatalogueSource.IsUnknown() || plan.CommunityActionTemplateId.IsUnknown() {
+		return
+	}
+
+	catalogueTemplate, diags := r.loadCatalogueTemplate(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() && plan.CatalogueVersion.ValueInt32() == catalogueTemplate.Version {
+		return
+	}
+
+	plan.CatalogueVersion = types.Int32Value(catalogueTemplate.Version)
+	if !req.State.Raw.IsNull() {
+		// Upgrading replaces the content of the installed step template, which gets a new version
+		plan.Name = types.StringValue(catalogueTemplate.Name)
+		plan.Description = types.StringValue(catalogueTemplate.Description)
+		plan.ActionType = types.StringValue(catalogueTemplate.ActionType)
+		plan.Version = types.Int32Unknown()
+		plan.Parameters = types.ListUnknown(plan.Parameters.ElementType(ctx))
+		plan.Packages = types.ListUnknown(plan.Packages.ElementType(ctx))
+		plan.Properties = types.MapUnknown(types.StringType)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 func (r *communityStepTemplateTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data schemas.StepTemplateFromCommunityStepTemplateTypeResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -76,6 +124,11 @@ func (r *communityStepTemplateTypeResource) Create(ctx context.Context, req reso
 		spaceId = r.Config.Client.GetSpaceID()
 	}
 
+	if !data.CatalogueSource.IsNull() {
+		r.installFromCatalogue(ctx, spaceId, &data, resp)
+		return
+	}
+
 	// Installing a community step template essentially creates a read only step template in the current space.
 	communityStepTemplate, err := r.Config.Client.CommunityActionTemplates.InstallToSpace(newCommunityStepTemplate, spaceId)
 
@@ -117,7 +170,35 @@ func (r *communityStepTemplateTypeResource) Read(ctx context.Context, req resour
 }
 
 func (r *communityStepTemplateTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Step templates based on community step templates are read only.
+	// Step templates based on community step templates are read only, unless they are installed from a catalogue.
+	var data schemas.StepTemplateFromCommunityStepTemplateTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.CatalogueSource.IsNull() {
+		return
+	}
+
+	catalogueTemplate, diags := r.loadCatalogueTemplate(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actionTemplate, err := actiontemplates.GetByID(r.Config.Client, data.SpaceID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unable to load step template", err.Error())
+		return
+	}
+
+	updateActionTemplateFromCatalogue(actionTemplate, data.CommunityActionTemplateId.ValueString(), catalogueTemplate)
+	actionTemplate, err = actiontemplates.Update(r.Config.Client, actionTemplate)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to upgrade step template from the catalogue", err.Error())
+		return
+	}
+
+	data.CatalogueVersion = types.Int32Value(catalogueTemplate.Version)
+	resp.Diagnostics.Append(mapCommunityStepTemplateToResourceModel(ctx, &data, actionTemplate)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Delete is used to uninstall the community step template by deleting the action template that was created when the community step template was installed.
@@ -135,6 +216,43 @@ func (r *communityStepTemplateTypeResource) Delete(ctx context.Context, req reso
 	}
 }
 
+// installFromCatalogue installs a template of a catalogue as a regular step template that records the ID of the catalogue template.
+func (r *communityStepTemplateTypeResource) installFromCatalogue(ctx context.Context, spaceId string, data *schemas.StepTemplateFromCommunityStepTemplateTypeResourceModel, resp *resource.CreateResponse) {
+	catalogueTemplate, diags := r.loadCatalogueTemplate(ctx, *data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actionTemplate, err := actiontemplates.Add(r.Config.Client, newActionTemplateFromCatalogue(spaceId, data.CommunityActionTemplateId.ValueString(), catalogueTemplate))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to install community step template from the catalogue", err.Error())
+		return
+	}
+
+	data.CatalogueVersion = types.Int32Value(catalogueTemplate.Version)
+	resp.Diagnostics.Append(mapCommunityStepTemplateToResourceModel(ctx, data, actionTemplate)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *communityStepTemplateTypeResource) loadCatalogueTemplate(ctx context.Context, data schemas.StepTemplateFromCommunityStepTemplateTypeResourceModel) (*actions.CommunityActionTemplate, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	catalogue, err := r.Config.getCommunityStepTemplateCatalogue(ctx, data.CatalogueSource.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("catalogue_source"), "unable to load community step template catalogue", err.Error())
+		return nil, diags
+	}
+
+	catalogueTemplate, err := catalogue.find(data.CommunityActionTemplateId.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("community_action_template_id"), "unable to find community step template", err.Error())
+		return nil, diags
+	}
+
+	return catalogueTemplate, diags
+}
+
 // The act of creating a community step template is actually installing a step template into the space.
 // The thing we return to the user is a regular action template, which is what they will use in their deployments.
 func mapCommunityStepTemplateToResourceModel(ctx context.Context, data *schemas.StepTemplateFromCommunityStepTemplateTypeResourceModel, at *actiontemplates.ActionTemplate) diag.Diagnostics {
@@ -145,7 +263,10 @@ func mapCommunityStepTemplateToResourceModel(ctx context.Context, data *schemas.
 	data.Name = types.StringValue(at.Name)
 	data.Version = types.Int32Value(at.Version)
 	data.Description = types.StringValue(at.Description)
-	data.CommunityActionTemplateId = types.StringValue(at.CommunityActionTemplateID)
+	data.CommunityActionTemplateId = types.StringValue(installedCommunityActionTemplateID(at))
+	if data.CatalogueSource.IsNull() {
+		data.CatalogueVersion = types.Int32Null()
+	}
 	data.ActionType = types.StringValue(at.ActionType)
 
 	// Parameters
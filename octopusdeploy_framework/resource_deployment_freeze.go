@@ -57,8 +57,8 @@ func (f *deploymentFreezeResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schemas.DeploymentFreezeSchema{}.GetResourceSchema()
 }
 
-func (f *deploymentFreezeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	f.Config = ResourceConfiguration(req, resp)
+func (f *deploymentFreezeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	f.Config = ResourceConfiguration(ctx, req, resp)
 
 	if f.Config != nil {
 		diags := f.Config.EnsureResourceCompatibilityByVersion(deploymentFreezeResourceName, "2025.1")
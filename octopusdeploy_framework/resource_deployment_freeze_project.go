@@ -37,8 +37,8 @@ func (d *deploymentFreezeProjectResource) Schema(_ context.Context, _ resource.S
 	resp.Schema = schemas.DeploymentFreezeProjectSchema{}.GetResourceSchema()
 }
 
-func (d *deploymentFreezeProjectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	d.Config = ResourceConfiguration(req, resp)
+func (d *deploymentFreezeProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (d *deploymentFreezeProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
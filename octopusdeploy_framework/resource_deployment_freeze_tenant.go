@@ -36,8 +36,8 @@ func (d *deploymentFreezeTenantResource) Schema(_ context.Context, _ resource.Sc
 	resp.Schema = schemas.DeploymentFreezeTenantSchema{}.GetResourceSchema()
 }
 
-func (d *deploymentFreezeTenantResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	d.Config = ResourceConfiguration(req, resp)
+func (d *deploymentFreezeTenantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	d.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (d *deploymentFreezeTenantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
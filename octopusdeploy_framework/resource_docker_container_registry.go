@@ -34,8 +34,8 @@ func (r *dockerContainerRegistryFeedTypeResource) Schema(ctx context.Context, re
 	resp.Schema = schemas.DockerContainerRegistryFeedSchema{}.GetResourceSchema()
 }
 
-func (r *dockerContainerRegistryFeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *dockerContainerRegistryFeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *dockerContainerRegistryFeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
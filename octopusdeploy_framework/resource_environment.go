@@ -31,8 +31,8 @@ func (r *environmentTypeResource) Schema(ctx context.Context, req resource.Schem
 	resp.Schema = schemas.EnvironmentSchema{}.GetResourceSchema()
 }
 
-func (r *environmentTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *environmentTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (*environmentTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
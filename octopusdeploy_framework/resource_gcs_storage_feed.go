@@ -32,8 +32,8 @@ func (r *gcsStorageFeedTypeResource) Schema(ctx context.Context, req resource.Sc
 	resp.Schema = schemas.GcsStorageFeedSchema{}.GetResourceSchema()
 }
 
-func (r *gcsStorageFeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *gcsStorageFeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *gcsStorageFeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -32,8 +32,8 @@ func (r *genericOidcAccountResource) Schema(_ context.Context, _ resource.Schema
 	resp.Schema = schemas.GenericOidcAccountSchema{}.GetResourceSchema()
 }
 
-func (r *genericOidcAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *genericOidcAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 func (r *genericOidcAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan schemas.GenericOidcAccountResourceModel
@@ -49,8 +49,8 @@ func (g *gitCredentialResource) Schema(_ context.Context, _ resource.SchemaReque
 	resp.Schema = schemas.GitCredentialSchema{}.GetResourceSchema()
 }
 
-func (g *gitCredentialResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	g.Config = ResourceConfiguration(req, resp)
+func (g *gitCredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	g.Config = ResourceConfiguration(ctx, req, resp)
 }
 func (g *gitCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan gitCredentialResourceModel
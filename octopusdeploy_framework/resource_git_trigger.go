@@ -35,8 +35,8 @@ func (r *gitTriggerResource) Schema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schemas.GitTriggerSchema{}.GetResourceSchema()
 }
 
-func (r *gitTriggerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *gitTriggerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *gitTriggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -34,8 +34,8 @@ func (r *githubRepositoryFeedTypeResource) Schema(ctx context.Context, req resou
 	resp.Schema = schemas.GitHubRepositoryFeedSchema{}.GetResourceSchema()
 }
 
-func (r *githubRepositoryFeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *githubRepositoryFeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *githubRepositoryFeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
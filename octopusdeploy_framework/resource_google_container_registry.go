@@ -34,8 +34,8 @@ func (r *googleContainerRegistryFeedTypeResource) Schema(ctx context.Context, re
 	resp.Schema = schemas.GoogleContainerRegistryFeedSchema{}.GetResourceSchema()
 }
 
-func (r *googleContainerRegistryFeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *googleContainerRegistryFeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *googleContainerRegistryFeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
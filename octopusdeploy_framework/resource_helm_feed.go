@@ -35,8 +35,8 @@ func (r *helmFeedTypeResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schemas.HelmFeedSchema{}.GetResourceSchema()
 }
 
-func (r *helmFeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *helmFeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *helmFeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -38,9 +38,9 @@ func (r *kubernetesMonitorResource) Schema(
 }
 
 func (r *kubernetesMonitorResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
 ) {
-	r.Config = ResourceConfiguration(req, resp)
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *kubernetesMonitorResource) Create(
@@ -32,8 +32,8 @@ func (r *libraryVariableSetFeedTypeResource) Schema(ctx context.Context, req res
 	resp.Schema = schemas.LibraryVariableSetSchema{}.GetResourceSchema()
 }
 
-func (r *libraryVariableSetFeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *libraryVariableSetFeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *libraryVariableSetFeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -70,7 +70,7 @@ func (r *lifecycleTypeResource) Schema(_ context.Context, _ resource.SchemaReque
 }
 
 func (r *lifecycleTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = resourceConfiguration(req, resp)
+	r.Config = ResourceConfiguration(ctx, req, resp)
 	if r.Config != nil && !internal.IsDeprecatedResourceEnabled(internal.DeprecationKeyLifecycleRetentionPolicy) {
 		resp.Diagnostics.Append(r.Config.EnsureResourceCompatibilityByVersion("lifecycle", "2025.3")...)
 	}
@@ -386,23 +386,6 @@ func attributeIsUsed(attribute types.List) bool {
 	return false
 }
 
-func resourceConfiguration(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *Config {
-	if req.ProviderData == nil {
-		return nil
-	}
-
-	p, ok := req.ProviderData.(*Config)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return nil
-	}
-
-	return p
-}
-
 func flattenResourceLifecycleDeprecated(lifecycle *lifecycles.Lifecycle, retentionWithoutStratUsed bool) *lifecycleTypeResourceModelDeprecated {
 	var flattenedLifecycle *lifecycleTypeResourceModelDeprecated
 	flattenedLifecycle = &lifecycleTypeResourceModelDeprecated{
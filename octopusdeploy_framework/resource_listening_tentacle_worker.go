@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/workers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"net/url"
 
@@ -34,8 +35,8 @@ func (r *listeningTentacleWorkerResource) Schema(ctx context.Context, req resour
 	resp.Schema = schemas.ListeningTentacleWorkerSchema{}.GetResourceSchema()
 }
 
-func (r *listeningTentacleWorkerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *listeningTentacleWorkerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *listeningTentacleWorkerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -56,7 +57,10 @@ func (r *listeningTentacleWorkerResource) Create(ctx context.Context, req resour
 		return
 	}
 
-	updateDataFromListeningTentacleWorker(ctx, data, data.SpaceID.ValueString(), createdWorker)
+	checkedWorker, diags := waitForWorkerHealthy(ctx, client, createdWorker, data.WaitForHealthy)
+	resp.Diagnostics.Append(diags...)
+
+	updateDataFromListeningTentacleWorker(ctx, data, data.SpaceID.ValueString(), checkedWorker)
 
 	tflog.Info(ctx, fmt.Sprintf("listening tentacle worker created (%s)", data.ID))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -113,6 +117,12 @@ func (r *listeningTentacleWorkerResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	if !data.Uri.Equal(state.Uri) || !data.Thumbprint.Equal(state.Thumbprint) || !data.ProxyID.Equal(state.ProxyID) || !data.WaitForHealthy.Equal(state.WaitForHealthy) {
+		var diags diag.Diagnostics
+		updatedWorker, diags = waitForWorkerHealthy(ctx, client, updatedWorker, data.WaitForHealthy)
+		resp.Diagnostics.Append(diags...)
+	}
+
 	updateDataFromListeningTentacleWorker(ctx, data, state.SpaceID.ValueString(), updatedWorker)
 
 	tflog.Info(ctx, fmt.Sprintf("listening tentacle worker updated (%s)", data.ID))
@@ -159,6 +169,7 @@ func updateDataFromListeningTentacleWorker(ctx context.Context, data *schemas.Li
 	data.Name = types.StringValue(worker.Name)
 	data.IsDisabled = types.BoolValue(worker.IsDisabled)
 	data.MachinePolicyID = types.StringValue(worker.MachinePolicyID)
+	data.HealthStatus = types.StringValue(worker.HealthStatus)
 	data.WorkerPoolIDs, _ = types.SetValueFrom(ctx, types.StringType, worker.WorkerPoolIDs)
 
 	endpoint := worker.Endpoint.(*machines.ListeningTentacleEndpoint)
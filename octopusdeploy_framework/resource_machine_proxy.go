@@ -29,8 +29,8 @@ func (r *machineProxyResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schemas.MachineProxySchema{}.GetResourceSchema()
 }
 
-func (r *machineProxyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *machineProxyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *machineProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -34,8 +34,8 @@ func (r *mavenFeedTypeResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schemas.MavenFeedSchema{}.GetResourceSchema()
 }
 
-func (r *mavenFeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *mavenFeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *mavenFeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -34,8 +34,8 @@ func (r *npmFeedTypeResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schemas.NpmFeedSchema{}.GetResourceSchema()
 }
 
-func (r *npmFeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *npmFeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *npmFeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -34,8 +34,8 @@ func (r *nugetFeedTypeResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schemas.NugetFeedSchema{}.GetResourceSchema()
 }
 
-func (r *nugetFeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *nugetFeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *nugetFeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
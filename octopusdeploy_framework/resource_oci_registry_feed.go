@@ -33,8 +33,8 @@ func (r *ociRegistryFeedTypeResource) Schema(ctx context.Context, req resource.S
 	resp.Schema = schemas.OCIRegistryFeedSchema{}.GetResourceSchema()
 }
 
-func (r *ociRegistryFeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *ociRegistryFeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *ociRegistryFeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
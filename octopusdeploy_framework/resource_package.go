@@ -0,0 +1,422 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &packageResource{}
+	_ resource.ResourceWithValidateConfig = &packageResource{}
+	_ resource.ResourceWithModifyPlan     = &packageResource{}
+)
+
+// uploadedPackageHashPrivateStateKey is the private state key of the hash that the server gave the uploaded package. A
+// replacement with the same package ID and version overwrites the package before the old resource is destroyed when it
+// is created first, so the hash tells whether the package in the repository is still the one this resource uploaded.
+const uploadedPackageHashPrivateStateKey = "uploaded_package_hash"
+
+type packageResource struct {
+	*Config
+}
+
+// packageFile is a package file to upload and the package it becomes in the built-in package repository.
+type packageFile struct {
+	packageID string
+	version   string
+	extension string
+}
+
+// fileName is the name the package is uploaded as, which the server reads the package ID and version from.
+func (f packageFile) fileName() string {
+	return f.packageID + "." + f.version + f.extension
+}
+
+func NewPackageResource() resource.Resource {
+	return &packageResource{}
+}
+
+func (r *packageResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.PackageResourceName)
+}
+
+func (r *packageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.PackageSchema{}.GetResourceSchema()
+}
+
+func (r *packageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
+}
+
+func (r *packageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config schemas.PackageResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Source.IsUnknown() || config.PackageID.IsUnknown() || config.Version.IsUnknown() {
+		return
+	}
+
+	if _, err := parsePackageFile(config.Source.ValueString(), config.PackageID.ValueString(), config.Version.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid package file", err.Error())
+	}
+}
+
+// ModifyPlan plans the package ID, version and hash of the source file, and replaces the package when any of them
+// change. The file may not exist yet when it is created during the apply, in which case the hash is only known then.
+// The hash is also only known after the upload when the overwrite mode is IgnoreIfExists, as the server may keep a
+// different package.
+func (r *packageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config schemas.PackageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Sha256 = types.StringUnknown()
+	if config.Source.IsUnknown() || config.PackageID.IsUnknown() || config.Version.IsUnknown() {
+		plan.PackageID, plan.Version, plan.FileExtension = unknownIfNull(config.PackageID), unknownIfNull(config.Version), types.StringUnknown()
+	} else {
+		file, err := parsePackageFile(config.Source.ValueString(), config.PackageID.ValueString(), config.Version.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid package file", err.Error())
+			return
+		}
+		plan.PackageID, plan.Version, plan.FileExtension = types.StringValue(file.packageID), types.StringValue(file.version), types.StringValue(file.extension)
+
+		if hash, err := packageFileSha256(config.Source.ValueString()); err == nil {
+			plan.Sha256 = types.StringValue(hash)
+		} else if !os.IsNotExist(err) {
+			resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to read package file", err.Error())
+			return
+		}
+	}
+
+	if req.State.Raw.IsNull() && plan.OverwriteMode.ValueString() == string(packages.OverwriteModeIgnoreIfExists) {
+		plan.Sha256 = types.StringUnknown()
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state schemas.PackageResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The server kept a different package instead of the file, so the file has no hash in the repository to compare
+		if state.Sha256.IsNull() && state.PackageID.Equal(plan.PackageID) && state.Version.Equal(plan.Version) && state.FileExtension.Equal(plan.FileExtension) {
+			plan.Sha256 = types.StringNull()
+		}
+
+		for attribute, values := range map[string][2]types.String{
+			"package_id":     {state.PackageID, plan.PackageID},
+			"version":        {state.Version, plan.Version},
+			"file_extension": {state.FileExtension, plan.FileExtension},
+			"sha256":         {state.Sha256, plan.Sha256},
+		} {
+			if !values[0].Equal(values[1]) {
+				resp.RequiresReplace = append(resp.RequiresReplace, path.Root(attribute))
+			}
+		}
+
+		if len(resp.RequiresReplace) > 0 {
+			plan.ID = types.StringUnknown()
+			plan.PackageSizeBytes = types.Int64Unknown()
+		} else {
+			plan.PackageSizeBytes = state.PackageSizeBytes
+		}
+	} else {
+		plan.PackageSizeBytes = types.Int64Unknown()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *packageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan schemas.PackageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := plan.SpaceID.ValueString()
+	if spaceID == "" {
+		spaceID = r.Client.GetSpaceID()
+	}
+
+	file, err := parsePackageFile(plan.Source.ValueString(), plan.PackageID.ValueString(), plan.Version.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid package file", err.Error())
+		return
+	}
+
+	hash, err := packageFileSha256(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to read package file", err.Error())
+		return
+	}
+	if !plan.Sha256.IsUnknown() && plan.Sha256.ValueString() != hash {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Package file changed", fmt.Sprintf("The package file %s changed after the plan was created. Plan the change again to upload the new file.", plan.Source.ValueString()))
+		return
+	}
+
+	useDeltaCompression := plan.UseDeltaCompression.ValueBool() && packageDeltaCompressionSupported(r.Client, spaceID)
+
+	tflog.Debug(ctx, "Uploading package", map[string]interface{}{
+		"file_name":             file.fileName(),
+		"source":                plan.Source.ValueString(),
+		"use_delta_compression": useDeltaCompression,
+	})
+
+	source, err := os.Open(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to read package file", err.Error())
+		return
+	}
+	defer source.Close()
+
+	uploaded, err := packages.UploadV2(r.Client, spaceID, file.fileName(), source, packages.OverwriteMode(plan.OverwriteMode.ValueString()), useDeltaCompression)
+	if err != nil {
+		resp.Diagnostics.AddError("Error uploading package", err.Error())
+		return
+	}
+
+	if uploaded.UploadInfo != nil {
+		tflog.Debug(ctx, "Uploaded package with delta compression", map[string]interface{}{
+			"file_size":       uploaded.UploadInfo.FileSize,
+			"delta_size":      uploaded.UploadInfo.DeltaSize,
+			"delta_behaviour": uploaded.UploadInfo.DeltaBehaviour,
+		})
+	}
+
+	plan.ID = types.StringValue(uploaded.GetID())
+	plan.SpaceID = types.StringValue(spaceID)
+	plan.Sha256 = types.StringValue(hash)
+	plan.PackageSizeBytes = types.Int64Value(int64(uploaded.PackageSizeBytes))
+
+	if !uploaded.CreatedNewFile {
+		sha1Hash, err := packageFileHash(plan.Source.ValueString(), sha1.New())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("source"), "Unable to read package file", err.Error())
+			return
+		}
+		if strings.EqualFold(sha1Hash, uploaded.Hash) {
+			resp.Diagnostics.AddWarning("Package already exists", fmt.Sprintf("Version %s of package %s is already in the built-in package repository with the same content as %s, so the existing package was kept.", file.version, file.packageID, plan.Source.ValueString()))
+		} else {
+			resp.Diagnostics.AddWarning("Package already exists", fmt.Sprintf("Version %s of package %s is already in the built-in package repository, so the existing package was kept instead of %s because the overwrite mode is %s. The package has a different content, so sha256 isn't set, a change to the file doesn't replace the package and the package isn't deleted on destroy.", file.version, file.packageID, plan.Source.ValueString(), plan.OverwriteMode.ValueString()))
+			plan.Sha256 = types.StringNull()
+			uploaded.Hash = ""
+		}
+	}
+
+	resp.Diagnostics.Append(setUploadedPackageHash(ctx, resp.Private, uploaded.Hash)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *packageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schemas.PackageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uploaded, err := getPackage(r.Client, state.SpaceID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if err := errors.ProcessApiErrorV2(ctx, resp, &state, err, "package"); err != nil {
+			resp.Diagnostics.AddError("Error reading package", err.Error())
+		}
+		return
+	}
+
+	state.PackageSizeBytes = types.Int64Value(int64(uploaded.PackageSizeBytes))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only changes the settings of the resource, as every change to the package itself replaces it.
+func (r *packageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan schemas.PackageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *packageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state schemas.PackageResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.DeleteOnDestroy.ValueBool() {
+		tflog.Debug(ctx, "Keeping package in the built-in package repository", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		return
+	}
+
+	if state.Sha256.IsNull() {
+		tflog.Debug(ctx, "Keeping package that this resource didn't upload", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		return
+	}
+
+	uploaded, err := getPackage(r.Client, state.SpaceID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if apiError, ok := err.(*core.APIError); ok && apiError.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading package", err.Error())
+		return
+	}
+
+	replaced, diags := uploadedPackageReplaced(ctx, req.Private, uploaded)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if replaced {
+		resp.Diagnostics.AddWarning("Package not deleted", fmt.Sprintf("Version %s of package %s in the built-in package repository was overwritten since this resource uploaded it, e.g. by the resource that replaces this one, so it was kept.", state.Version.ValueString(), state.PackageID.ValueString()))
+		return
+	}
+
+	if err := packages.DeleteByID(r.Client, state.SpaceID.ValueString(), state.ID.ValueString()); err != nil {
+		if apiError, ok := err.(*core.APIError); ok && apiError.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting package", err.Error())
+	}
+}
+
+// parsePackageFile works out the package that a file becomes, from the configured package ID and version or else from
+// the name of the file.
+func parsePackageFile(source string, packageID string, version string) (packageFile, error) {
+	name := filepath.Base(source)
+
+	file := packageFile{packageID: packageID, version: version}
+	for _, extension := range schemas.PackageFileExtensions {
+		if len(name) > len(extension) && strings.EqualFold(name[len(name)-len(extension):], extension) {
+			file.extension = strings.ToLower(extension)
+			break
+		}
+	}
+	if file.extension == "" {
+		return packageFile{}, fmt.Errorf("%s is not a supported package file. The supported extensions are %s", name, strings.Join(schemas.PackageFileExtensions, ", "))
+	}
+
+	if packageID == "" || version == "" {
+		var err error
+		file.packageID, file.version, err = packages.ParsePackageIDAndVersion(name)
+		if err != nil {
+			return packageFile{}, fmt.Errorf("the package ID and version can't be read from the file name %s, which should look like <package_id>.<version><extension>, e.g. bootstrap.1.0.0.zip. Rename the file or set package_id and version", name)
+		}
+	}
+
+	if _, _, err := packages.ParsePackageIDAndVersion(file.fileName()); err != nil {
+		return packageFile{}, fmt.Errorf("%s is not a valid package ID and version", file.fileName())
+	}
+	return file, nil
+}
+
+func packageFileSha256(source string) (string, error) {
+	return packageFileHash(source, sha256.New())
+}
+
+// packageFileHash is the hex encoded hash of a package file. The server hashes packages with SHA-1.
+func packageFileHash(source string, hash hash.Hash) (string, error) {
+	file, err := os.Open(source)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// setUploadedPackageHash saves the hash that the server gave the uploaded package. An empty hash means that the package
+// in the repository isn't the one this resource uploaded.
+func setUploadedPackageHash(ctx context.Context, private privateState, hash string) diag.Diagnostics {
+	if hash == "" {
+		return private.SetKey(ctx, uploadedPackageHashPrivateStateKey, nil)
+	}
+
+	value, err := json.Marshal(hash)
+	if err != nil {
+		diags := diag.Diagnostics{}
+		diags.AddError("Unable to save the hash of the uploaded package", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, uploadedPackageHashPrivateStateKey, value)
+}
+
+// uploadedPackageReplaced reports whether the package in the repository was overwritten since this resource uploaded
+// it. Packages uploaded before the hash was saved are taken to be unchanged.
+func uploadedPackageReplaced(ctx context.Context, private privateState, current *packages.PackageUploadResponse) (bool, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, uploadedPackageHashPrivateStateKey)
+	if diags.HasError() || value == nil {
+		return false, diags
+	}
+
+	var uploaded string
+	if err := json.Unmarshal(value, &uploaded); err != nil {
+		diags.AddError("Unable to read the hash of the uploaded package", err.Error())
+		return false, diags
+	}
+	return !strings.EqualFold(uploaded, current.Hash), diags
+}
+
+// packageDeltaCompressionSupported reports whether a space links to the delta signatures of packages, which the server
+// needs for a delta upload.
+func packageDeltaCompressionSupported(octopus *client.Client, spaceID string) bool {
+	spaceRoot, err := newclient.Get[resources.Resource](octopus.HttpSession(), "/api/"+spaceID)
+	if err != nil {
+		return false
+	}
+	_, ok := spaceRoot.Links[constants.LinkPackageDeltaSignature]
+	return ok
+}
+
+func getPackage(octopus *client.Client, spaceID string, id string) (*packages.PackageUploadResponse, error) {
+	return newclient.Get[packages.PackageUploadResponse](octopus.HttpSession(), fmt.Sprintf("/api/%s/packages/%s", spaceID, id))
+}
+
+func unknownIfNull(value types.String) types.String {
+	if value.IsNull() {
+		return types.StringUnknown()
+	}
+	return value
+}
@@ -0,0 +1,85 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"crypto/sha1"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePackageFile(t *testing.T) {
+	tests := []struct {
+		source    string
+		packageID string
+		version   string
+		expected  packageFile
+	}{
+		{"bootstrap.1.0.0.zip", "", "", packageFile{"bootstrap", "1.0.0", ".zip"}},
+		{"dist/Config.Web.2.1.0-beta.3.tar.gz", "", "", packageFile{"Config.Web", "2.1.0-beta.3", ".tar.gz"}},
+		{"lib/app.1.2.JAR", "", "", packageFile{"app", "1.2", ".jar"}},
+		{"build/output.zip", "scripts", "3.0.1", packageFile{"scripts", "3.0.1", ".zip"}},
+		{"nuget/Acme.Tools.4.0.0.nupkg", "", "", packageFile{"Acme.Tools", "4.0.0", ".nupkg"}},
+	}
+
+	for _, test := range tests {
+		file, err := parsePackageFile(test.source, test.packageID, test.version)
+		require.NoError(t, err, test.source)
+		assert.Equal(t, test.expected, file, test.source)
+	}
+
+	assert.Equal(t, "Config.Web.2.1.0.tar.gz", packageFile{"Config.Web", "2.1.0", ".tar.gz"}.fileName())
+}
+
+func TestParsePackageFileInvalid(t *testing.T) {
+	for _, test := range [][3]string{
+		{"bootstrap.1.0.0.exe", "", ""},
+		{"output.zip", "", ""},
+		{"output.zip", "scripts", "latest"},
+	} {
+		_, err := parsePackageFile(test[0], test[1], test[2])
+		assert.Error(t, err, test[0])
+	}
+}
+
+func TestPackageFileSha256(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "bootstrap.1.0.0.zip")
+	require.NoError(t, os.WriteFile(source, []byte("hello"), 0o600))
+
+	hash, err := packageFileSha256(source)
+	require.NoError(t, err)
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", hash)
+
+	_, err = packageFileSha256(filepath.Join(t.TempDir(), "missing.1.0.0.zip"))
+	assert.True(t, os.IsNotExist(err))
+
+	hash, err = packageFileHash(source, sha1.New())
+	require.NoError(t, err)
+	assert.Equal(t, "aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d", hash)
+}
+
+func TestUploadedPackageReplaced(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+
+	replaced, diags := uploadedPackageReplaced(ctx, private, &packages.PackageUploadResponse{Hash: "aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"})
+	require.False(t, diags.HasError())
+	assert.False(t, replaced, "a package uploaded before the hash was saved is deleted")
+
+	require.False(t, setUploadedPackageHash(ctx, private, "AAF4C61DDCC5E8A2DABEDE0F3B482CD9AEA9434D").HasError())
+
+	replaced, diags = uploadedPackageReplaced(ctx, private, &packages.PackageUploadResponse{Hash: "aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"})
+	require.False(t, diags.HasError())
+	assert.False(t, replaced)
+
+	replaced, diags = uploadedPackageReplaced(ctx, private, &packages.PackageUploadResponse{Hash: "f572d396fae9206628714fb2ce00f72e94f2258f"})
+	require.False(t, diags.HasError())
+	assert.True(t, replaced, "a replacement with the same package ID and version overwrote the package")
+
+	require.False(t, setUploadedPackageHash(ctx, private, "").HasError())
+	assert.NotContains(t, private, uploadedPackageHashPrivateStateKey)
+}
@@ -33,8 +33,8 @@ func (r *parentEnvironmentResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schemas.ParentEnvironmentSchema{}.GetResourceSchema()
 }
 
-func (r *parentEnvironmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *parentEnvironmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *parentEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -32,8 +32,8 @@ func (a *platformHubAwsAccountResource) Schema(_ context.Context, _ resource.Sch
 	resp.Schema = schemas.PlatformHubAwsAccountSchema{}.GetResourceSchema()
 }
 
-func (a *platformHubAwsAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	a.Config = ResourceConfiguration(req, resp)
+func (a *platformHubAwsAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	a.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (a *platformHubAwsAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -31,8 +31,8 @@ func (a *platformHubAwsOpenIDConnectAccountResource) Schema(_ context.Context, _
 	resp.Schema = schemas.PlatformHubAwsOpenIDConnectAccountSchema{}.GetResourceSchema()
 }
 
-func (a *platformHubAwsOpenIDConnectAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	a.Config = ResourceConfiguration(req, resp)
+func (a *platformHubAwsOpenIDConnectAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	a.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (a *platformHubAwsOpenIDConnectAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
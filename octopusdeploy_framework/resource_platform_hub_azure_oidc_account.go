@@ -32,8 +32,8 @@ func (a *platformHubAzureOidcAccountResource) Schema(_ context.Context, _ resour
 	resp.Schema = schemas.PlatformHubAzureOidcAccountSchema{}.GetResourceSchema()
 }
 
-func (a *platformHubAzureOidcAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	a.Config = ResourceConfiguration(req, resp)
+func (a *platformHubAzureOidcAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	a.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (a *platformHubAzureOidcAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
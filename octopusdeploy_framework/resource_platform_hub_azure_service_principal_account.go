@@ -32,8 +32,8 @@ func (a *platformHubAzureServicePrincipalAccountResource) Schema(_ context.Conte
 	resp.Schema = schemas.PlatformHubAzureServicePrincipalAccountSchema{}.GetResourceSchema()
 }
 
-func (a *platformHubAzureServicePrincipalAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	a.Config = ResourceConfiguration(req, resp)
+func (a *platformHubAzureServicePrincipalAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	a.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (a *platformHubAzureServicePrincipalAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -32,8 +32,8 @@ func (g *platformHubGcpAccountResource) Schema(_ context.Context, _ resource.Sch
 	resp.Schema = schemas.PlatformHubGcpAccountSchema{}.GetResourceSchema()
 }
 
-func (g *platformHubGcpAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	g.Config = ResourceConfiguration(req, resp)
+func (g *platformHubGcpAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	g.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (g *platformHubGcpAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -32,8 +32,8 @@ func (g *platformHubGenericOidcAccountResource) Schema(_ context.Context, _ reso
 	resp.Schema = schemas.PlatformHubGenericOidcAccountSchema{}.GetResourceSchema()
 }
 
-func (g *platformHubGenericOidcAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	g.Config = ResourceConfiguration(req, resp)
+func (g *platformHubGenericOidcAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	g.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (g *platformHubGenericOidcAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
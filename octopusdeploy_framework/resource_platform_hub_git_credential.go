@@ -45,8 +45,8 @@ func (g *platformHubGitCredentialResource) Schema(_ context.Context, _ resource.
 	resp.Schema = schemas.PlatformHubGitCredentialSchema{}.GetResourceSchema()
 }
 
-func (g *platformHubGitCredentialResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	g.Config = ResourceConfiguration(req, resp)
+func (g *platformHubGitCredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	g.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (g *platformHubGitCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
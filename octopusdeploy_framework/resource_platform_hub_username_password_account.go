@@ -32,8 +32,8 @@ func (u *platformHubUsernamePasswordAccountResource) Schema(_ context.Context, _
 	resp.Schema = schemas.PlatformHubUsernamePasswordAccountSchema{}.GetResourceSchema()
 }
 
-func (u *platformHubUsernamePasswordAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	u.Config = ResourceConfiguration(req, resp)
+func (u *platformHubUsernamePasswordAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	u.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (u *platformHubUsernamePasswordAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
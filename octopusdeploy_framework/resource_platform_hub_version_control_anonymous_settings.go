@@ -31,8 +31,8 @@ func (r *platformHubVersionControlAnonymousSettingsResource) Schema(_ context.Co
 	resp.Schema = schemas.PlatformHubVersionControlAnonymousSettingsSchema{}.GetResourceSchema()
 }
 
-func (r *platformHubVersionControlAnonymousSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *platformHubVersionControlAnonymousSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *platformHubVersionControlAnonymousSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
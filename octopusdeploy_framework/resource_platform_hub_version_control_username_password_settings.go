@@ -32,8 +32,8 @@ func (r *platformHubVersionControlUsernamePasswordSettingsResource) Schema(_ con
 	resp.Schema = schemas.PlatformHubVersionControlUsernamePasswordSettingsSchema{}.GetResourceSchema()
 }
 
-func (r *platformHubVersionControlUsernamePasswordSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *platformHubVersionControlUsernamePasswordSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *platformHubVersionControlUsernamePasswordSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -35,8 +35,8 @@ func (r *processResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	resp.Schema = schemas.ProcessSchema{}.GetResourceSchema()
 }
 
-func (r *processResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *processResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *processResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
@@ -16,7 +16,10 @@ import (
 	"strings"
 )
 
-var _ resource.ResourceWithImportState = &processChildStepResource{}
+var (
+	_ resource.ResourceWithImportState = &processChildStepResource{}
+	_ resource.ResourceWithModifyPlan  = &processChildStepResource{}
+)
 
 type processChildStepResource struct {
 	*Config
@@ -34,8 +37,8 @@ func (r *processChildStepResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schemas.ProcessChildStepSchema{}.GetResourceSchema()
 }
 
-func (r *processChildStepResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *processChildStepResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *processChildStepResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
@@ -54,6 +57,24 @@ func (r *processChildStepResource) ImportState(ctx context.Context, request reso
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), identifiers[2])...)
 }
 
+func (r *processChildStepResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() || r.Config.Offline {
+		return // When deleting, variable references are analysed when the provider configuration is known
+	}
+
+	var spaceID, processID types.String
+	var executionProperties types.Map
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("space_id"), &spaceID)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("process_id"), &processID)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("execution_properties"), &executionProperties)...)
+	if response.Diagnostics.HasError() || processID.IsUnknown() {
+		return
+	}
+
+	definitions := loadProcessVariableDefinitions(ctx, r.Config, spaceID.ValueString(), processID.ValueString())
+	response.Diagnostics.Append(warnAboutUndefinedVariableReferencesInMap(definitions, path.Root("execution_properties"), executionProperties)...)
+}
+
 func (r *processChildStepResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *schemas.ProcessChildStepResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
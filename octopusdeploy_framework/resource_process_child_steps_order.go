@@ -37,8 +37,8 @@ func (r *processChildStepsOrderResource) Schema(_ context.Context, _ resource.Sc
 	resp.Schema = schemas.ProcessChildStepsOrderSchema{}.GetResourceSchema()
 }
 
-func (r *processChildStepsOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *processChildStepsOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *processChildStepsOrderResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
@@ -111,6 +111,10 @@ func (r *processChildStepsOrderResource) ModifyPlan(ctx context.Context, req res
 		return
 	}
 
+	if r.Config.Offline {
+		return
+	}
+
 	spaceId := state.SpaceID.ValueString()
 	processId := state.ProcessID.ValueString()
 	parentId := state.ParentID.ValueString()
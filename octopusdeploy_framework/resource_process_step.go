@@ -3,6 +3,7 @@ package octopusdeploy_framework
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
@@ -21,7 +22,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ resource.ResourceWithImportState = &processStepResource{}
+var (
+	_ resource.ResourceWithImportState    = &processStepResource{}
+	_ resource.ResourceWithModifyPlan     = &processStepResource{}
+	_ resource.ResourceWithValidateConfig = &processStepResource{}
+)
 
 type processStepResource struct {
 	*Config
@@ -39,8 +44,90 @@ func (r *processStepResource) Schema(_ context.Context, _ resource.SchemaRequest
 	resp.Schema = schemas.ProcessStepSchema{}.GetResourceSchema()
 }
 
-func (r *processStepResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *processStepResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
+}
+
+func (r *processStepResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var actionType types.String
+	var executionProperties types.Map
+	var scriptFile types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("type"), &actionType)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("execution_properties"), &executionProperties)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("script_file"), &scriptFile)...)
+
+	blocks := make(map[string]types.Object, len(schemas.ProcessStepActionBlocks))
+	for _, block := range schemas.ProcessStepActionBlocks {
+		var value types.Object
+		response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(block.Name), &value)...)
+		blocks[block.Name] = value
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(validateProcessStepActionBlocks(actionType, executionProperties, blocks)...)
+	response.Diagnostics.Append(validateProcessStepScriptFile(scriptFile, executionProperties, blocks)...)
+}
+
+func (r *processStepResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return // When deleting
+	}
+
+	var actionType types.String
+	var executionProperties types.Map
+	var scriptFile types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("type"), &actionType)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("execution_properties"), &executionProperties)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("script_file"), &scriptFile)...)
+
+	blocks := make(map[string]types.Object, len(schemas.ProcessStepActionBlocks))
+	for _, block := range schemas.ProcessStepActionBlocks {
+		var value types.Object
+		response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(block.Name), &value)...)
+		blocks[block.Name] = value
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	script, diags := plannedProcessStepScript(scriptFile, executionProperties, blocks)
+	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("script_sha256"), plannedScriptSha256(script))...)
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(warnAboutScriptFileChanges(ctx, request.Private, path.Root("script_file"), scriptFile, script)...)
+	}
+
+	// The script of a script file is validated as the script body execution property it is sent as
+	if !scriptFile.IsNull() && !executionProperties.IsNull() && !executionProperties.IsUnknown() {
+		properties := maps.Clone(executionProperties.Elements())
+		properties[schemas.ProcessStepScriptBodyPropertyKey] = script
+		executionProperties = types.MapValueMust(types.StringType, properties)
+	}
+	response.Diagnostics.Append(validateProcessStepExecutionProperties(actionType, executionProperties, blocks)...)
+
+	if r.Config.Offline {
+		return // Variable references are analysed when the provider configuration is known
+	}
+
+	var spaceID, processID types.String
+	var properties, configuredExecutionProperties types.Map
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("space_id"), &spaceID)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("process_id"), &processID)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("properties"), &properties)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("execution_properties"), &configuredExecutionProperties)...)
+	if response.Diagnostics.HasError() || processID.IsUnknown() {
+		return
+	}
+
+	definitions := loadProcessVariableDefinitions(ctx, r.Config, spaceID.ValueString(), processID.ValueString())
+	response.Diagnostics.Append(warnAboutUndefinedVariableReferencesInMap(definitions, path.Root("properties"), properties)...)
+	response.Diagnostics.Append(warnAboutUndefinedVariableReferencesInMap(definitions, path.Root("execution_properties"), configuredExecutionProperties)...)
+	for _, block := range schemas.ProcessStepActionBlocks {
+		response.Diagnostics.Append(warnAboutUndefinedVariableReferencesInObject(definitions, path.Root(block.Name), blocks[block.Name])...)
+	}
+	response.Diagnostics.Append(warnAboutUndefinedVariableReferences(definitions, path.Root("script_file"), script)...)
 }
 
 func (r *processStepResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
@@ -122,6 +209,8 @@ func (r *processStepResource) Create(ctx context.Context, req resource.CreateReq
 		data.StartTrigger = plannedStartTrigger
 	}
 
+	resp.Diagnostics.Append(setAppliedScript(ctx, resp.Private, data.ScriptFile, processStepScriptBody(createdStep))...)
+
 	tflog.Info(ctx, fmt.Sprintf("process step created (%s)", data.ID))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -156,6 +245,7 @@ func (r *processStepResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	mapProcessStepToState(process, step, data)
+	resp.Diagnostics.Append(setAppliedScript(ctx, resp.Private, data.ScriptFile, processStepScriptBody(step))...)
 
 	tflog.Info(ctx, fmt.Sprintf("process step read (%s)", step.GetID()))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -208,6 +298,7 @@ func (r *processStepResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	mapProcessStepToState(updatedProcess, updatedStep, data)
+	resp.Diagnostics.Append(setAppliedScript(ctx, resp.Private, data.ScriptFile, processStepScriptBody(updatedStep))...)
 
 	tflog.Info(ctx, fmt.Sprintf("process step updated (%s)", updatedStep.GetID()))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -342,6 +433,16 @@ func mapProcessStepActionFromState(ctx context.Context, state *schemas.ProcessSt
 	if diags.HasError() {
 		return diags
 	}
+	mapProcessStepActionBlocksFromState(state, action.Properties)
+
+	if !state.ScriptFile.IsNull() {
+		script, err := readAppliedScriptFile(state.ScriptFile, state.ScriptSha256)
+		if err != nil {
+			diags.AddAttributeError(path.Root("script_file"), "Unable to read script file", err.Error())
+			return diags
+		}
+		action.Properties[schemas.ProcessStepScriptBodyPropertyKey] = core.NewPropertyValue(script, false)
+	}
 
 	return diag.Diagnostics{}
 }
@@ -542,8 +643,24 @@ func mapProcessStepActionToState(action *deployments.DeploymentAction, state *sc
 	state.GitDependencies = mapGitDependenciesToState(action.GitDependencies)
 	state.PrimaryPackage, state.Packages = mapPackageReferencesToState(action.Packages)
 
+	// The script of a script file is kept out of the typed blocks and execution properties
+	properties := action.Properties
+	state.ScriptSha256 = types.StringNull()
+	if script, ok := properties[schemas.ProcessStepScriptBodyPropertyKey]; ok {
+		state.ScriptSha256 = types.StringValue(util.ScriptSha256(script.Value))
+		if !state.ScriptFile.IsNull() {
+			properties = maps.Clone(properties)
+			delete(properties, schemas.ProcessStepScriptBodyPropertyKey)
+		}
+	}
+
+	// Properties configured by a typed block are kept out of the execution properties
+	blockProperties := mapProcessStepActionBlocksToState(properties, state)
+	executionProperties := maps.Clone(properties)
+	maps.DeleteFunc(executionProperties, func(key string, _ core.PropertyValue) bool { return blockProperties[key] })
+
 	diags := diag.Diagnostics{}
-	state.ExecutionProperties, diags = mapActionExecutionPropertiesToState(action.Properties, state.ExecutionProperties)
+	state.ExecutionProperties, diags = mapActionExecutionPropertiesToState(executionProperties, state.ExecutionProperties)
 
 	return diags
 }
@@ -677,3 +794,162 @@ func isBooleanLikeString(s string) bool {
 	lower := strings.ToLower(s)
 	return lower == "true" || lower == "false"
 }
+
+// validateProcessStepActionBlocks checks that at most one typed block is set, that it matches the type of the step and
+// that its execution properties are not also set in execution_properties.
+func validateProcessStepActionBlocks(actionType types.String, executionProperties types.Map, blocks map[string]types.Object) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	var configured []schemas.ProcessStepActionBlock
+	for _, block := range schemas.ProcessStepActionBlocks {
+		if value, ok := blocks[block.Name]; ok && !value.IsNull() {
+			configured = append(configured, block)
+		}
+	}
+
+	for i, block := range configured {
+		if i > 0 {
+			diags.AddAttributeError(
+				path.Root(block.Name),
+				"Conflicting step type blocks",
+				fmt.Sprintf("Only one step type block can be set, but both %q and %q are set.", configured[0].Name, block.Name),
+			)
+			continue
+		}
+
+		if !actionType.IsNull() && !actionType.IsUnknown() && actionType.ValueString() != block.ActionType {
+			diags.AddAttributeError(
+				path.Root(block.Name),
+				"Step type block does not match the step type",
+				fmt.Sprintf("The %q block configures steps of type %q, but the type of the step is %q.", block.Name, block.ActionType, actionType.ValueString()),
+			)
+		}
+
+		if executionProperties.IsNull() || executionProperties.IsUnknown() {
+			continue
+		}
+		for key := range executionProperties.Elements() {
+			if property, ok := block.PropertyByKey(key); ok {
+				diags.AddAttributeError(
+					path.Root("execution_properties").AtMapKey(key),
+					"Execution property is configured by a step type block",
+					fmt.Sprintf("The execution property %q is configured by the %q attribute of the %q block. Remove it from execution_properties.", key, property.Attribute, block.Name),
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+// mapProcessStepActionBlocksFromState adds the execution properties configured by typed blocks to the properties of the action.
+func mapProcessStepActionBlocksFromState(state *schemas.ProcessStepResourceModel, properties map[string]core.PropertyValue) {
+	blocks := state.ActionBlocks()
+	for _, block := range schemas.ProcessStepActionBlocks {
+		value := blocks[block.Name]
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		attributes := value.Attributes()
+		for _, property := range block.Properties {
+			if propertyValue, ok := schemas.ProcessStepActionPropertyValue(attributes[property.Attribute]); ok {
+				properties[property.Key] = core.NewPropertyValue(propertyValue, false)
+			}
+		}
+	}
+}
+
+// mapProcessStepActionBlocksToState sets the typed blocks configured in the state from the execution properties of the
+// action and returns the keys of the properties that the blocks hold. Blocks that are not configured stay null, so
+// imported steps keep all of their properties in execution_properties.
+func mapProcessStepActionBlocksToState(properties map[string]core.PropertyValue, state *schemas.ProcessStepResourceModel) map[string]bool {
+	blockProperties := make(map[string]bool)
+
+	blocks := state.ActionBlocks()
+	for _, block := range schemas.ProcessStepActionBlocks {
+		value := blocks[block.Name]
+		if value.IsNull() {
+			continue
+		}
+
+		attributes := make(map[string]attr.Value, len(block.Properties))
+		for _, property := range block.Properties {
+			attributes[property.Attribute] = property.NullValue()
+			propertyValue, ok := properties[property.Key]
+			if !ok {
+				continue
+			}
+			if attributeValue, ok := schemas.ProcessStepActionAttributeValue(property, propertyValue.Value); ok {
+				attributes[property.Attribute] = attributeValue
+				blockProperties[property.Key] = true
+			}
+		}
+		*value = types.ObjectValueMust(block.AttributeTypes(), attributes)
+	}
+
+	return blockProperties
+}
+
+// validateProcessStepScriptFile checks that the script of a step with a script file is not also set in the execution
+// properties or the run_script block.
+func validateProcessStepScriptFile(scriptFile types.String, executionProperties types.Map, blocks map[string]types.Object) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if scriptFile.IsNull() {
+		return diags
+	}
+
+	if !executionProperties.IsNull() && !executionProperties.IsUnknown() {
+		if _, ok := executionProperties.Elements()[schemas.ProcessStepScriptBodyPropertyKey]; ok {
+			diags.AddAttributeError(
+				path.Root("execution_properties").AtMapKey(schemas.ProcessStepScriptBodyPropertyKey),
+				"Conflicting script",
+				fmt.Sprintf("The script of the step is loaded from script_file. Remove %q from execution_properties.", schemas.ProcessStepScriptBodyPropertyKey),
+			)
+		}
+	}
+
+	if runScript, ok := blocks["run_script"]; ok && !runScript.IsNull() && !runScript.IsUnknown() {
+		if scriptBody := runScript.Attributes()["script_body"]; scriptBody != nil && !scriptBody.IsNull() {
+			diags.AddAttributeError(
+				path.Root("run_script").AtName("script_body"),
+				"Conflicting script",
+				"The script of the step is loaded from script_file. Remove script_body from the run_script block.",
+			)
+		}
+	}
+
+	return diags
+}
+
+// plannedProcessStepScript returns the planned script of a step, which is loaded from the script file or configured in
+// the execution properties or the run_script block.
+func plannedProcessStepScript(scriptFile types.String, executionProperties types.Map, blocks map[string]types.Object) (types.String, diag.Diagnostics) {
+	if !scriptFile.IsNull() {
+		return readPlannedScriptFile(path.Root("script_file"), scriptFile)
+	}
+
+	if executionProperties.IsUnknown() {
+		return types.StringUnknown(), diag.Diagnostics{}
+	}
+	if script, ok := executionProperties.Elements()[schemas.ProcessStepScriptBodyPropertyKey].(types.String); ok {
+		return script, diag.Diagnostics{}
+	}
+
+	runScript := blocks["run_script"]
+	if runScript.IsUnknown() {
+		return types.StringUnknown(), diag.Diagnostics{}
+	}
+	if script, ok := runScript.Attributes()["script_body"].(types.String); ok {
+		return script, diag.Diagnostics{}
+	}
+	return types.StringNull(), diag.Diagnostics{}
+}
+
+// processStepScriptBody returns the script body of the first action of a step.
+func processStepScriptBody(step *deployments.DeploymentStep) string {
+	if len(step.Actions) == 0 || step.Actions[0] == nil {
+		return ""
+	}
+	return step.Actions[0].Properties[schemas.ProcessStepScriptBodyPropertyKey].Value
+}
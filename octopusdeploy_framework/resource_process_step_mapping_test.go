@@ -2,6 +2,8 @@ package octopusdeploy_framework
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
@@ -11,9 +13,11 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbookprocess"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccMapProcessStepFromStateWithAllAttributes(t *testing.T) {
@@ -408,6 +412,7 @@ func TestAccMapProcessStepToStateWithAllAttributes(t *testing.T) {
 			"Octopus.Action.RunOnServer":       types.StringValue("True"),
 			"Octopus.Action.Script.ScriptBody": types.StringValue("Write-Host \"Step 1, Action 1\""),
 		}),
+		ScriptSha256: types.StringValue(util.ScriptSha256("Write-Host \"Step 1, Action 1\"")),
 	}
 	expectedState.ActionID = types.StringValue(step.Actions[0].ID)
 	expectedState.ID = types.StringValue(step.ID)
@@ -578,9 +583,157 @@ func TestAccMapProcessStepToStateWithAllAttributesForRunbook(t *testing.T) {
 			"Octopus.Action.RunOnServer":       types.StringValue("True"),
 			"Octopus.Action.Script.ScriptBody": types.StringValue("Write-Host \"Step 1, Action 1\""),
 		}),
+		ScriptSha256: types.StringValue(util.ScriptSha256("Write-Host \"Step 1, Action 1\"")),
 	}
 	expectedState.ID = types.StringValue(step.ID)
 	expectedState.ActionID = types.StringValue(step.Actions[0].ID)
 
 	assert.Equal(t, expectedState, state)
 }
+
+func runScriptBlockValue(attributes map[string]attr.Value) types.Object {
+	block, _ := schemas.GetProcessStepActionBlock("run_script")
+	values := map[string]attr.Value{}
+	for _, property := range block.Properties {
+		values[property.Attribute] = property.NullValue()
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+	return types.ObjectValueMust(block.AttributeTypes(), values)
+}
+
+func TestMapProcessStepActionBlocksFromState(t *testing.T) {
+	state := schemas.ProcessStepResourceModel{
+		RunScript: runScriptBlockValue(map[string]attr.Value{
+			"script_source": types.StringValue("Inline"),
+			"syntax":        types.StringValue("Bash"),
+			"script_body":   types.StringValue("echo hello"),
+			"run_on_server": types.BoolValue(true),
+		}),
+	}
+	properties := map[string]core.PropertyValue{
+		"Octopus.Action.Custom": core.NewPropertyValue("passed through", false),
+	}
+
+	mapProcessStepActionBlocksFromState(&state, properties)
+
+	assert.Equal(t, map[string]core.PropertyValue{
+		"Octopus.Action.Custom":              core.NewPropertyValue("passed through", false),
+		"Octopus.Action.Script.ScriptSource": core.NewPropertyValue("Inline", false),
+		"Octopus.Action.Script.Syntax":       core.NewPropertyValue("Bash", false),
+		"Octopus.Action.Script.ScriptBody":   core.NewPropertyValue("echo hello", false),
+		"Octopus.Action.RunOnServer":         core.NewPropertyValue("True", false),
+	}, properties)
+}
+
+func TestMapProcessStepActionBlocksToState(t *testing.T) {
+	action := deployments.NewDeploymentAction("Run Script", "Octopus.Script")
+	action.Properties = map[string]core.PropertyValue{
+		"Octopus.Action.Script.ScriptSource": core.NewPropertyValue("Inline", false),
+		"Octopus.Action.Script.Syntax":       core.NewPropertyValue("Bash", false),
+		"Octopus.Action.Script.ScriptBody":   core.NewPropertyValue("echo hello", false),
+		"Octopus.Action.RunOnServer":         core.NewPropertyValue("#{RunOnServer}", false),
+		"Octopus.Action.Custom":              core.NewPropertyValue("passed through", false),
+	}
+	state := schemas.ProcessStepResourceModel{
+		RunScript:           runScriptBlockValue(map[string]attr.Value{"script_body": types.StringValue("echo")}),
+		ExecutionProperties: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+	}
+
+	diags := mapProcessStepActionToState(action, &state)
+	assert.False(t, diags.HasError(), "Expected no errors in diagnostics")
+
+	assert.Equal(t, runScriptBlockValue(map[string]attr.Value{
+		"script_source": types.StringValue("Inline"),
+		"syntax":        types.StringValue("Bash"),
+		"script_body":   types.StringValue("echo hello"),
+	}), state.RunScript)
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Octopus.Action.Custom":      types.StringValue("passed through"),
+		"Octopus.Action.RunOnServer": types.StringValue("#{RunOnServer}"),
+	}), state.ExecutionProperties, "properties the block can not hold stay in the execution properties")
+	assert.True(t, state.Email.IsNull(), "blocks that are not configured stay null")
+}
+
+func TestValidateProcessStepActionBlocks(t *testing.T) {
+	runScript := runScriptBlockValue(map[string]attr.Value{"script_body": types.StringValue("echo")})
+	executionProperties := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Octopus.Action.Script.ScriptBody": types.StringValue("echo"),
+		"Octopus.Action.Custom":            types.StringValue("value"),
+	})
+
+	diags := validateProcessStepActionBlocks(types.StringValue("Octopus.Script"), types.MapNull(types.StringType), map[string]types.Object{"run_script": runScript})
+	assert.False(t, diags.HasError())
+
+	diags = validateProcessStepActionBlocks(types.StringValue("Octopus.Manual"), executionProperties, map[string]types.Object{"run_script": runScript})
+	assert.Equal(t, 2, diags.ErrorsCount())
+	assert.Contains(t, diags.Errors()[0].Detail(), `The "run_script" block configures steps of type "Octopus.Script", but the type of the step is "Octopus.Manual"`)
+	assert.Contains(t, diags.Errors()[1].Detail(), `The execution property "Octopus.Action.Script.ScriptBody" is configured by the "script_body" attribute`)
+
+	email, _ := schemas.GetProcessStepActionBlock("email")
+	diags = validateProcessStepActionBlocks(types.StringUnknown(), types.MapNull(types.StringType), map[string]types.Object{
+		"run_script": runScript,
+		"email":      types.ObjectUnknown(email.AttributeTypes()),
+	})
+	assert.Equal(t, 1, diags.ErrorsCount())
+	assert.Contains(t, diags.Errors()[0].Detail(), `both "run_script" and "email" are set`)
+}
+
+func TestMapProcessStepScriptFileToState(t *testing.T) {
+	action := deployments.NewDeploymentAction("Run Script", "Octopus.Script")
+	action.Properties = map[string]core.PropertyValue{
+		"Octopus.Action.Script.Syntax":     core.NewPropertyValue("Bash", false),
+		"Octopus.Action.Script.ScriptBody": core.NewPropertyValue("echo hello\r\n", false),
+	}
+	state := schemas.ProcessStepResourceModel{
+		ScriptFile:          types.StringValue("scripts/hello.sh"),
+		RunScript:           runScriptBlockValue(map[string]attr.Value{"syntax": types.StringValue("Bash")}),
+		ExecutionProperties: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+	}
+
+	diags := mapProcessStepActionToState(action, &state)
+	assert.False(t, diags.HasError(), "Expected no errors in diagnostics")
+
+	assert.Equal(t, types.StringValue(util.ScriptSha256("echo hello\n")), state.ScriptSha256, "the hash is independent of line endings")
+	assert.Equal(t, runScriptBlockValue(map[string]attr.Value{"syntax": types.StringValue("Bash")}), state.RunScript, "the script of a script file is not held by the block")
+	assert.Empty(t, state.ExecutionProperties.Elements())
+	assert.Len(t, action.Properties, 2, "the properties of the action are not changed")
+}
+
+func TestPlannedProcessStepScript(t *testing.T) {
+	scriptFile := filepath.Join(t.TempDir(), "hello.sh")
+	require.NoError(t, os.WriteFile(scriptFile, []byte("echo hello\r\necho world\r\n"), 0o600))
+
+	script, diags := plannedProcessStepScript(types.StringValue(scriptFile), types.MapNull(types.StringType), nil)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue("echo hello\necho world\n"), script)
+
+	_, diags = plannedProcessStepScript(types.StringValue(filepath.Join(t.TempDir(), "missing.sh")), types.MapNull(types.StringType), nil)
+	assert.True(t, diags.HasError())
+
+	executionProperties := types.MapValueMust(types.StringType, map[string]attr.Value{"Octopus.Action.Script.ScriptBody": types.StringValue("echo inline")})
+	script, _ = plannedProcessStepScript(types.StringNull(), executionProperties, nil)
+	assert.Equal(t, types.StringValue("echo inline"), script)
+
+	runScript := runScriptBlockValue(map[string]attr.Value{"script_body": types.StringValue("echo block")})
+	script, _ = plannedProcessStepScript(types.StringNull(), types.MapValueMust(types.StringType, map[string]attr.Value{}), map[string]types.Object{"run_script": runScript})
+	assert.Equal(t, types.StringValue("echo block"), script)
+
+	script, _ = plannedProcessStepScript(types.StringNull(), types.MapUnknown(types.StringType), nil)
+	assert.True(t, script.IsUnknown())
+}
+
+func TestValidateProcessStepScriptFile(t *testing.T) {
+	executionProperties := types.MapValueMust(types.StringType, map[string]attr.Value{"Octopus.Action.Script.ScriptBody": types.StringValue("echo")})
+	runScript := runScriptBlockValue(map[string]attr.Value{"script_body": types.StringValue("echo")})
+	blocks := map[string]types.Object{"run_script": runScript}
+
+	diags := validateProcessStepScriptFile(types.StringNull(), executionProperties, blocks)
+	assert.False(t, diags.HasError())
+
+	diags = validateProcessStepScriptFile(types.StringValue("hello.sh"), executionProperties, blocks)
+	assert.Equal(t, 2, diags.ErrorsCount())
+	assert.Contains(t, diags.Errors()[0].Detail(), `Remove "Octopus.Action.Script.ScriptBody" from execution_properties`)
+	assert.Contains(t, diags.Errors()[1].Detail(), "Remove script_body from the run_script block")
+}
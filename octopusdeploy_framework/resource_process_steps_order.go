@@ -36,8 +36,8 @@ func (r *processStepsOrderResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schemas.ProcessStepsOrderSchema{}.GetResourceSchema()
 }
 
-func (r *processStepsOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *processStepsOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *processStepsOrderResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
@@ -85,6 +85,10 @@ func (r *processStepsOrderResource) ModifyPlan(ctx context.Context, req resource
 		return
 	}
 
+	if r.Config.Offline {
+		return
+	}
+
 	spaceId := state.SpaceID.ValueString()
 	processId := state.ProcessID.ValueString()
 
@@ -19,8 +19,9 @@ import (
 )
 
 var (
-	_ resource.ResourceWithImportState = &processTemplatedChildStepResource{}
-	_ resource.ResourceWithModifyPlan  = &processTemplatedChildStepResource{}
+	_ resource.ResourceWithImportState    = &processTemplatedChildStepResource{}
+	_ resource.ResourceWithModifyPlan     = &processTemplatedChildStepResource{}
+	_ resource.ResourceWithValidateConfig = &processTemplatedChildStepResource{}
 )
 
 type processTemplatedChildStepResource struct {
@@ -39,8 +40,8 @@ func (r *processTemplatedChildStepResource) Schema(_ context.Context, _ resource
 	resp.Schema = schemas.ProcessTemplatedChildStepSchema{}.GetResourceSchema()
 }
 
-func (r *processTemplatedChildStepResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *processTemplatedChildStepResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *processTemplatedChildStepResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
@@ -103,33 +104,58 @@ func (r *processTemplatedChildStepResource) ImportState(ctx context.Context, req
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("template_version"), version)...)
 }
 
+func (r *processTemplatedChildStepResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	response.Diagnostics.Append(validateTemplatedStepVersionConfig(ctx, request.Config)...)
+}
+
 func (r *processTemplatedChildStepResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	if request.Plan.Raw.IsNull() {
 		return // When deleting
 	}
 
-	if request.State.Raw.IsNull() {
-		return // When creating
+	if r.Config.Offline {
+		return // The template is loaded when the provider configuration is known
 	}
 
-	var plan *schemas.ProcessTemplatedChildStepResourceModel
-	diags := request.Plan.Get(ctx, &plan)
+	template, diags := planTemplatedStepUpgrade(ctx, r.Config.Client, request.Config, &response.Plan)
 	if diags.HasError() {
 		response.Diagnostics.Append(diags...)
 		return
 	}
 
-	spaceId := plan.SpaceID.ValueString()
-	templateId := plan.TemplateID.ValueString()
-	templateVersion := plan.TemplateVersion.ValueInt32()
+	if template == nil {
+		template, diags = loadPlannedTemplatedStepTemplate(ctx, r.Config.Client, response.Plan)
+		if diags.HasError() {
+			response.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	if template != nil {
+		var parameters types.Map
+		response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+		response.Diagnostics.Append(validateTemplatedStepParameters(ctx, template, parameters)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+	response.Diagnostics.Append(warnAboutUndefinedTemplatedStepVariableReferences(ctx, r.Config, response.Plan, template)...)
+
+	if request.State.Raw.IsNull() {
+		return // When creating
+	}
 
-	var template *actiontemplates.ActionTemplate
-	template, diags = loadActionTemplate(r.Config.Client, spaceId, templateId, templateVersion)
+	var plan *schemas.ProcessTemplatedChildStepResourceModel
+	diags = response.Plan.Get(ctx, &plan)
 	if diags.HasError() {
 		response.Diagnostics.Append(diags...)
 		return
 	}
 
+	if template == nil {
+		return // The template is loaded when the template and its version are known
+	}
+
 	// Explicitly set computed attributes to avoid "state drift",
 	// because terraform complains about differences between plan and state after apply
 
@@ -204,9 +230,8 @@ func (r *processTemplatedChildStepResource) Create(ctx context.Context, req reso
 	processId := data.ProcessID.ValueString()
 	parentId := data.ParentID.ValueString()
 	templateId := data.TemplateID.ValueString()
-	templateVersion := data.TemplateVersion.ValueInt32()
 
-	template, templateDiags := loadActionTemplate(r.Config.Client, spaceId, templateId, templateVersion)
+	template, templateDiags := loadTemplatedStepActionTemplate(r.Config.Client, spaceId, templateId, data.TemplateVersion)
 	if templateDiags.HasError() {
 		resp.Diagnostics.Append(templateDiags...)
 		return
@@ -331,9 +356,8 @@ func (r *processTemplatedChildStepResource) Update(ctx context.Context, req reso
 	parentId := data.ParentID.ValueString()
 	actionId := data.ID.ValueString()
 	templateId := data.TemplateID.ValueString()
-	templateVersion := data.TemplateVersion.ValueInt32()
 
-	template, templateDiags := loadActionTemplate(r.Config.Client, spaceId, templateId, templateVersion)
+	template, templateDiags := loadTemplatedStepActionTemplate(r.Config.Client, spaceId, templateId, data.TemplateVersion)
 	if templateDiags.HasError() {
 		resp.Diagnostics.Append(templateDiags...)
 		return
@@ -515,6 +539,9 @@ func mapProcessTemplatedChildStepActionToState(ctx context.Context, process proc
 	}
 	state.TemplateID = properties.TemplateID
 	state.TemplateVersion = properties.TemplateVersion
+	if state.AutoUpgrade.IsNull() {
+		state.AutoUpgrade = types.BoolValue(false) // When importing
+	}
 	state.Parameters = properties.Parameters
 	state.UnmanagedParameters = properties.UnmanagedParameters
 	state.TemplateProperties = properties.TemplateProperties
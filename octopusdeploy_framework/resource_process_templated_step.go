@@ -16,15 +16,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 )
 
 var (
-	_ resource.ResourceWithImportState = &processTemplatedStepResource{}
-	_ resource.ResourceWithModifyPlan  = &processTemplatedStepResource{}
+	_ resource.ResourceWithImportState    = &processTemplatedStepResource{}
+	_ resource.ResourceWithModifyPlan     = &processTemplatedStepResource{}
+	_ resource.ResourceWithValidateConfig = &processTemplatedStepResource{}
 )
 
 type processTemplatedStepResource struct {
@@ -43,8 +47,8 @@ func (r *processTemplatedStepResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schemas.ProcessTemplatedStepSchema{}.GetResourceSchema()
 }
 
-func (r *processTemplatedStepResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *processTemplatedStepResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *processTemplatedStepResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
@@ -105,33 +109,58 @@ func (r *processTemplatedStepResource) ImportState(ctx context.Context, request
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("template_version"), version)...)
 }
 
+func (r *processTemplatedStepResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	response.Diagnostics.Append(validateTemplatedStepVersionConfig(ctx, request.Config)...)
+}
+
 func (r *processTemplatedStepResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	if request.Plan.Raw.IsNull() {
 		return // When deleting
 	}
 
-	if request.State.Raw.IsNull() {
-		return // When creating
+	if r.Config.Offline {
+		return // The template is loaded when the provider configuration is known
 	}
 
-	var plan *schemas.ProcessTemplatedStepResourceModel
-	diags := request.Plan.Get(ctx, &plan)
+	template, diags := planTemplatedStepUpgrade(ctx, r.Config.Client, request.Config, &response.Plan)
 	if diags.HasError() {
 		response.Diagnostics.Append(diags...)
 		return
 	}
 
-	spaceId := plan.SpaceID.ValueString()
-	templateId := plan.TemplateID.ValueString()
-	templateVersion := plan.TemplateVersion.ValueInt32()
+	if template == nil {
+		template, diags = loadPlannedTemplatedStepTemplate(ctx, r.Config.Client, response.Plan)
+		if diags.HasError() {
+			response.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	if template != nil {
+		var parameters types.Map
+		response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+		response.Diagnostics.Append(validateTemplatedStepParameters(ctx, template, parameters)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+	response.Diagnostics.Append(warnAboutUndefinedTemplatedStepVariableReferences(ctx, r.Config, response.Plan, template)...)
+
+	if request.State.Raw.IsNull() {
+		return // When creating
+	}
 
-	var template *actiontemplates.ActionTemplate
-	template, diags = loadActionTemplate(r.Config.Client, spaceId, templateId, templateVersion)
+	var plan *schemas.ProcessTemplatedStepResourceModel
+	diags = response.Plan.Get(ctx, &plan)
 	if diags.HasError() {
 		response.Diagnostics.Append(diags...)
 		return
 	}
 
+	if template == nil {
+		return // The template is loaded when the template and its version are known
+	}
+
 	// Explicitly set computed attributes to avoid "state drift",
 	// because terraform complains about differences between plan and state after apply
 
@@ -205,9 +234,8 @@ func (r *processTemplatedStepResource) Create(ctx context.Context, req resource.
 	spaceId := data.SpaceID.ValueString()
 	processId := data.ProcessID.ValueString()
 	templateId := data.TemplateID.ValueString()
-	templateVersion := data.TemplateVersion.ValueInt32()
 
-	template, templateDiags := loadActionTemplate(r.Config.Client, spaceId, templateId, templateVersion)
+	template, templateDiags := loadTemplatedStepActionTemplate(r.Config.Client, spaceId, templateId, data.TemplateVersion)
 	if templateDiags.HasError() {
 		resp.Diagnostics.Append(templateDiags...)
 		return
@@ -312,9 +340,8 @@ func (r *processTemplatedStepResource) Update(ctx context.Context, req resource.
 	processId := data.ProcessID.ValueString()
 	stepId := data.ID.ValueString()
 	templateId := data.TemplateID.ValueString()
-	templateVersion := data.TemplateVersion.ValueInt32()
 
-	template, templateDiags := loadActionTemplate(r.Config.Client, spaceId, templateId, templateVersion)
+	template, templateDiags := loadTemplatedStepActionTemplate(r.Config.Client, spaceId, templateId, data.TemplateVersion)
 	if templateDiags.HasError() {
 		resp.Diagnostics.Append(templateDiags...)
 		return
@@ -542,6 +569,9 @@ func mapProcessTemplatedStepActionToState(ctx context.Context, action *deploymen
 	}
 	state.TemplateID = properties.TemplateID
 	state.TemplateVersion = properties.TemplateVersion
+	if state.AutoUpgrade.IsNull() {
+		state.AutoUpgrade = types.BoolValue(false) // When importing
+	}
 	state.Parameters = properties.Parameters
 	state.UnmanagedParameters = properties.UnmanagedParameters
 	state.TemplateProperties = properties.TemplateProperties
@@ -728,3 +758,95 @@ func loadActionTemplate(client *client.Client, spaceId string, id string, versio
 	versioned.SetID(id)
 	return versioned, diags
 }
+
+// loadTemplatedStepActionTemplate loads the template version a templated step is based on. The latest version is
+// loaded when the version is not known yet, which happens when auto_upgrade is enabled and the plan was made offline.
+func loadTemplatedStepActionTemplate(client *client.Client, spaceId string, id string, version types.Int32) (*actiontemplates.ActionTemplate, diag.Diagnostics) {
+	if !version.IsUnknown() && !version.IsNull() {
+		return loadActionTemplate(client, spaceId, id, version.ValueInt32())
+	}
+
+	diags := diag.Diagnostics{}
+	latest, err := actiontemplates.GetByID(client, spaceId, id)
+	if err != nil {
+		diags.AddError("Unable to load template", err.Error())
+		return nil, diags
+	}
+	return latest, diags
+}
+
+// validateTemplatedStepVersionConfig checks that a templated step either pins template_version or enables auto_upgrade.
+func validateTemplatedStepVersionConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var templateVersion types.Int32
+	var autoUpgrade types.Bool
+	diags := config.GetAttribute(ctx, path.Root("template_version"), &templateVersion)
+	diags.Append(config.GetAttribute(ctx, path.Root("auto_upgrade"), &autoUpgrade)...)
+	if diags.HasError() || autoUpgrade.IsUnknown() {
+		return diags
+	}
+
+	if autoUpgrade.ValueBool() && !templateVersion.IsNull() {
+		diags.AddAttributeError(path.Root("template_version"), "Conflicting template version", "The `template_version` attribute cannot be set when `auto_upgrade` is enabled, because the latest version of the template is used.")
+	}
+	if !autoUpgrade.ValueBool() && templateVersion.IsNull() {
+		diags.AddAttributeError(path.Root("template_version"), "Missing template version", "The `template_version` attribute is required unless `auto_upgrade` is enabled.")
+	}
+	return diags
+}
+
+// planTemplatedStepUpgrade plans the latest version of the template of a step with auto_upgrade enabled, and checks
+// that the configured parameters are still declared by it. Nil is returned when auto_upgrade is disabled or the template
+// is not known yet.
+func planTemplatedStepUpgrade(ctx context.Context, client *client.Client, config tfsdk.Config, plan *tfsdk.Plan) (*actiontemplates.ActionTemplate, diag.Diagnostics) {
+	var autoUpgrade types.Bool
+	var spaceId types.String
+	var templateId types.String
+	var parameters types.Map
+	diags := plan.GetAttribute(ctx, path.Root("auto_upgrade"), &autoUpgrade)
+	diags.Append(config.GetAttribute(ctx, path.Root("space_id"), &spaceId)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("template_id"), &templateId)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	if diags.HasError() || !autoUpgrade.ValueBool() || spaceId.IsUnknown() || templateId.IsUnknown() {
+		return nil, diags
+	}
+
+	template, loadDiags := loadTemplatedStepActionTemplate(client, spaceId.ValueString(), templateId.ValueString(), types.Int32Unknown())
+	diags.Append(loadDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if !parameters.IsUnknown() {
+		diags.Append(validateUpgradedTemplateParameters(ctx, template, parameters)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	diags.Append(plan.SetAttribute(ctx, path.Root("template_version"), template.Version)...)
+	return template, diags
+}
+
+// validateUpgradedTemplateParameters checks that the configured parameters of a step are declared by the version of
+// the template the step is upgraded to.
+func validateUpgradedTemplateParameters(ctx context.Context, template *actiontemplates.ActionTemplate, parameters types.Map) diag.Diagnostics {
+	configuredParameters, diags := util.ConvertMapToStringMap(ctx, parameters)
+	if diags.HasError() {
+		return diags
+	}
+
+	declared := make(map[string]bool, len(template.Parameters))
+	for _, parameter := range template.Parameters {
+		declared[parameter.Name] = true
+	}
+	for _, name := range slices.Sorted(maps.Keys(configuredParameters)) {
+		if !declared[name] {
+			diags.AddAttributeError(
+				path.Root("parameters"),
+				"Unable to upgrade process step",
+				fmt.Sprintf("Parameter '%s' is not declared by version %d of template '%s'. Remove it from the parameters to upgrade the step.", name, template.Version, template.Name),
+			)
+		}
+	}
+	return diags
+}
@@ -1,9 +1,15 @@
 package octopusdeploy_framework
 
 import (
+	"context"
 	"fmt"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"strings"
 	"testing"
 )
@@ -191,3 +197,46 @@ func newProcessTemplatedStepTestDependenciesConfiguration(scenario string, param
 		config:   configuration,
 	}
 }
+
+func TestValidateUpgradedTemplateParameters(t *testing.T) {
+	template := actiontemplates.NewActionTemplate("Template", "Octopus.Script")
+	template.Version = 3
+	template.Parameters = []actiontemplates.ActionTemplateParameter{{Name: "Kept"}, {Name: "Added"}}
+
+	parameters, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{"Kept": "value"})
+	assert.False(t, validateUpgradedTemplateParameters(context.Background(), template, parameters).HasError())
+
+	parameters, _ = types.MapValueFrom(context.Background(), types.StringType, map[string]string{"Kept": "value", "Removed": "value"})
+	diags := validateUpgradedTemplateParameters(context.Background(), template, parameters)
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, "Parameter 'Removed' is not declared by version 3 of template 'Template'. Remove it from the parameters to upgrade the step.", diags.Errors()[0].Detail())
+}
+
+func TestValidateTemplatedStepParameters(t *testing.T) {
+	withDefault := core.NewPropertyValue("default", false)
+	template := actiontemplates.NewActionTemplate("Template", "Octopus.Script")
+	template.Version = 2
+	template.Parameters = []actiontemplates.ActionTemplateParameter{
+		{Name: "Environment", DisplaySettings: map[string]string{"Octopus.ControlType": "Select", "Octopus.SelectOptions": "dev|Development\nprod|Production"}},
+		{Name: "Verbose", DisplaySettings: map[string]string{"Octopus.ControlType": "Checkbox"}, DefaultValue: &withDefault},
+		{Name: "Optional"},
+	}
+
+	parameters, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{"Environment": "prod", "Verbose": "#{Verbose}", "Optional": ""})
+	diags := validateTemplatedStepParameters(context.Background(), template, parameters)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 0, diags.WarningsCount())
+
+	parameters, _ = types.MapValueFrom(context.Background(), types.StringType, map[string]string{"Enviroment": "prod", "Verbose": "yes"})
+	diags = validateTemplatedStepParameters(context.Background(), template, parameters)
+	require.Equal(t, 2, diags.ErrorsCount())
+	assert.Equal(t, "Parameter 'Enviroment' is not declared by version 2 of template 'Template'. Did you mean 'Environment'?", diags.Errors()[0].Detail())
+	assert.Equal(t, "The value of checkbox parameter 'Verbose' must be 'True' or 'False', but is 'yes'.", diags.Errors()[1].Detail())
+	require.Equal(t, 2, diags.WarningsCount(), "parameters without a value or default value are reported")
+	assert.Contains(t, diags.Warnings()[0].Detail(), "Parameter 'Environment' of template 'Template' has no default value")
+
+	parameters, _ = types.MapValueFrom(context.Background(), types.StringType, map[string]string{"Environment": "test", "Optional": "value"})
+	diags = validateTemplatedStepParameters(context.Background(), template, parameters)
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Equal(t, "The value of parameter 'Environment' must be one of 'dev', 'prod', but is 'test'.", diags.Errors()[0].Detail())
+}
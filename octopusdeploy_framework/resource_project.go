@@ -3,10 +3,15 @@ package octopusdeploy_framework
 import (
 	"context"
 	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/extensions"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
@@ -19,6 +24,7 @@ import (
 
 var _ resource.Resource = &projectResource{}
 var _ resource.ResourceWithImportState = &projectResource{}
+var _ resource.ResourceWithModifyPlan = &projectResource{}
 
 type projectResource struct {
 	*Config
@@ -36,25 +42,161 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	resp.Schema = schemas.ProjectSchema{}.GetResourceSchema()
 }
 
-func (r *projectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
+}
+
+func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(util.ForwardDeprecatedValues(ctx, req.Config, &resp.Plan)...)
+	if !r.Config.Offline {
+		resp.Diagnostics.Append(warnAboutUndefinedReleaseNotesVariableReferences(ctx, r.Config, req.Plan)...)
+	}
+
+	// The project being cloned is planned as cloned_from_project_id, unless cloned_from_project_id is configured
+	var cloneFromProjectID, configuredClonedFromProjectID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("clone_from_project_id"), &cloneFromProjectID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cloned_from_project_id"), &configuredClonedFromProjectID)...)
+	if resp.Diagnostics.HasError() || cloneFromProjectID.IsNull() || !configuredClonedFromProjectID.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cloned_from_project_id"), cloneFromProjectID)...)
+}
+
+// cloneProject clones the source project, which copies its deployment process, runbooks, variables and channels, and
+// then updates the clone with the planned values. Attributes that are not known until the project is created, such as
+// the deployment process ID, are taken from the clone.
+func (r *projectResource) cloneProject(ctx context.Context, sourceProjectID string, plan *projectResourceModel) (*projects.Project, error) {
+	sourceProject, err := projects.GetByID(r.Client, plan.SpaceID.ValueString(), sourceProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load project %s: %w", sourceProjectID, err)
+	}
+
+	clonedProject, err := r.Client.Projects.Clone(sourceProject, projects.ProjectCloneRequest{
+		Name:           plan.Name.ValueString(),
+		Description:    plan.Description.ValueString(),
+		ProjectGroupID: plan.ProjectGroupID.ValueString(),
+		LifecycleID:    plan.LifecycleID.ValueString(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Project cloned (%s), applying the planned values", clonedProject.GetID()))
+
+	flattenedClone, diags := flattenProject(ctx, clonedProject, plan)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to flatten project %s: %v", clonedProject.GetID(), diags)
+	}
+	replaceUnknownValues(plan, flattenedClone)
+
+	project := expandProject(ctx, *plan)
+	keepClonedProjectSettings(project, clonedProject, *plan)
+	project.ID = clonedProject.ID
+	project.Links = clonedProject.Links
+	project.ClonedFromProjectID = clonedProject.ClonedFromProjectID
+	// The clone is converted to version control afterwards, like a new project
+	project.PersistenceSettings = clonedProject.PersistenceSettings
+	project.IsVersionControlled = clonedProject.IsVersionControlled
+
+	return projects.Update(r.Client, project)
+}
+
+// replaceUnknownValues sets the attributes of the model that are unknown to the values of the other model.
+func replaceUnknownValues(model *projectResourceModel, other *projectResourceModel) {
+	modelValue := reflect.ValueOf(model).Elem()
+	otherValue := reflect.ValueOf(other).Elem()
+	for i := 0; i < modelValue.NumField(); i++ {
+		if value, ok := modelValue.Field(i).Interface().(attr.Value); ok && value.IsUnknown() {
+			modelValue.Field(i).Set(otherValue.Field(i))
+		}
+	}
+}
+
+// keepClonedProjectSettings sets the settings of the project whose blocks are empty in the plan to the settings of the
+// existing project, so that the templates and other settings copied from the source project of a clone aren't removed.
+func keepClonedProjectSettings(project *projects.Project, existingProject *projects.Project, plan projectResourceModel) {
+	if len(plan.Template.Elements()) == 0 {
+		project.Templates = existingProject.Templates
+	}
+	if len(plan.ConnectivityPolicy.Elements()) == 0 {
+		project.ConnectivityPolicy = existingProject.ConnectivityPolicy
+	}
+	if len(plan.VersioningStrategy.Elements()) == 0 {
+		project.VersioningStrategy = existingProject.VersioningStrategy
+	}
+	if len(plan.ReleaseCreationStrategy.Elements()) == 0 {
+		project.ReleaseCreationStrategy = existingProject.ReleaseCreationStrategy
+	}
+	if len(plan.AutoDeployReleaseOverrides.Elements()) == 0 {
+		project.AutoDeployReleaseOverrides = existingProject.AutoDeployReleaseOverrides
+	}
+	for _, extensionSetting := range existingProject.ExtensionSettings {
+		switch extensionSetting.ExtensionID() {
+		case extensions.JiraServiceManagementExtensionID:
+			if len(plan.JiraServiceManagementExtensionSettings.Elements()) == 0 {
+				project.ExtensionSettings = append(project.ExtensionSettings, extensionSetting)
+			}
+		case extensions.ServiceNowExtensionID:
+			if len(plan.ServiceNowExtensionSettings.Elements()) == 0 {
+				project.ExtensionSettings = append(project.ExtensionSettings, extensionSetting)
+			}
+		}
+	}
+}
+
+// keepUnconfiguredClonedBlocks keeps the blocks of the model that are empty in the plan, as the settings a cloned
+// project copied from its source project are left unmanaged until their blocks are configured.
+func keepUnconfiguredClonedBlocks(model *projectResourceModel, plan projectResourceModel) {
+	blocks := []struct {
+		value   *types.List
+		planned types.List
+	}{
+		{&model.Template, plan.Template},
+		{&model.ConnectivityPolicy, plan.ConnectivityPolicy},
+		{&model.VersioningStrategy, plan.VersioningStrategy},
+		{&model.ReleaseCreationStrategy, plan.ReleaseCreationStrategy},
+		{&model.AutoDeployReleaseOverrides, plan.AutoDeployReleaseOverrides},
+		{&model.JiraServiceManagementExtensionSettings, plan.JiraServiceManagementExtensionSettings},
+		{&model.ServiceNowExtensionSettings, plan.ServiceNowExtensionSettings},
+	}
+	for _, block := range blocks {
+		if len(block.planned.Elements()) == 0 {
+			*block.value = block.planned
+		}
+	}
 }
 
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan projectResourceModel
-	diags := req.Plan.Get(ctx, &plan)
+	var data projectResourceStateModel
+	diags := req.Plan.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan := data.projectResourceModel
 
 	project := expandProject(ctx, plan)
 	// PersistenceSettings.Password doesn't return from API so this is work around
 	persistenceSettings := project.PersistenceSettings
-	createdProject, err := projects.Add(r.Client, project)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating project", err.Error())
-		return
+
+	var createdProject *projects.Project
+	var err error
+	if !data.CloneFromProjectID.IsNull() {
+		createdProject, err = r.cloneProject(ctx, data.CloneFromProjectID.ValueString(), &plan)
+		if err != nil {
+			resp.Diagnostics.AddError("Error cloning project", err.Error())
+			return
+		}
+	} else {
+		createdProject, err = projects.Add(r.Client, project)
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating project", err.Error())
+			return
+		}
 	}
 
 	if persistenceSettings != nil && persistenceSettings.Type() == projects.PersistenceSettingsTypeVersionControlled {
@@ -76,23 +218,27 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if !data.CloneFromProjectID.IsNull() {
+		keepUnconfiguredClonedBlocks(flattenedProject, plan)
+	}
 
 	deploymentDiags := r.updateStateWithDeploymentSettings(createdProject, flattenedProject, &plan)
 	if deploymentDiags.HasError() {
 		return
 	}
 
-	diags = resp.State.Set(ctx, flattenedProject)
+	diags = resp.State.Set(ctx, projectResourceStateModel{projectResourceModel: *flattenedProject, CloneFromProjectID: data.CloneFromProjectID})
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state projectResourceModel
-	diags := req.State.Get(ctx, &state)
+	var data projectResourceStateModel
+	diags := req.State.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state := data.projectResourceModel
 	stateProject := expandProject(ctx, state)
 	// PersistenceSettings.Password doesn't return from API so this is work around
 	persistenceSettings := stateProject.PersistenceSettings
@@ -113,22 +259,26 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if !data.CloneFromProjectID.IsNull() {
+		keepUnconfiguredClonedBlocks(flattenedProject, state)
+	}
 
 	diagFromUpdate := r.updateStateWithDeploymentSettings(project, flattenedProject, &state)
 	if diagFromUpdate.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, flattenedProject)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, projectResourceStateModel{projectResourceModel: *flattenedProject, CloneFromProjectID: data.CloneFromProjectID})...)
 }
 
 func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan projectResourceModel
-	diags := req.Plan.Get(ctx, &plan)
+	var data projectResourceStateModel
+	diags := req.Plan.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan := data.projectResourceModel
 
 	existingProject, err := projects.GetByID(r.Client, plan.SpaceID.ValueString(), plan.ID.ValueString())
 	if err != nil {
@@ -137,6 +287,9 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	updatedProject := expandProject(ctx, plan)
+	if !data.CloneFromProjectID.IsNull() {
+		keepClonedProjectSettings(updatedProject, existingProject, plan)
+	}
 	updatedProject.ID = existingProject.ID
 	updatedProject.Links = existingProject.Links
 	// PersistenceSettings.Password doesn't return from API so this is work around
@@ -194,18 +347,21 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if !data.CloneFromProjectID.IsNull() {
+		keepUnconfiguredClonedBlocks(flattenedProject, plan)
+	}
 
 	diagFromUpdate := r.updateStateWithDeploymentSettings(updatedProject, flattenedProject, &plan)
 	if diagFromUpdate.HasError() {
 		return
 	}
 
-	diags = resp.State.Set(ctx, flattenedProject)
+	diags = resp.State.Set(ctx, projectResourceStateModel{projectResourceModel: *flattenedProject, CloneFromProjectID: data.CloneFromProjectID})
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state projectResourceModel
+	var state projectResourceStateModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -31,8 +31,8 @@ func (r *projectAutoCreateReleaseResource) Schema(_ context.Context, _ resource.
 	resp.Schema = schemas.ProjectAutoCreateReleaseSchema{}.GetResourceSchema()
 }
 
-func (r *projectAutoCreateReleaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *projectAutoCreateReleaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *projectAutoCreateReleaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -41,8 +41,8 @@ func (f *projectDeploymentFreezeResource) Schema(_ context.Context, _ resource.S
 	resp.Schema = schemas.ProjectDeploymentFreezeSchema{}.GetResourceSchema()
 }
 
-func (f *projectDeploymentFreezeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	f.Config = ResourceConfiguration(req, resp)
+func (f *projectDeploymentFreezeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	f.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (f *projectDeploymentFreezeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -35,9 +35,9 @@ func (r *projectGroupTypeResource) Schema(
 }
 
 func (r *projectGroupTypeResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse,
 ) {
-	r.Config = ResourceConfiguration(req, resp)
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *projectGroupTypeResource) Create(
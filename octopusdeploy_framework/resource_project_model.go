@@ -45,6 +45,14 @@ type projectResourceModel struct {
 	schemas.ResourceModel
 }
 
+// projectResourceStateModel adds the attributes that only the project resource has to projectResourceModel, which
+// the projects data source shares.
+type projectResourceStateModel struct {
+	projectResourceModel
+
+	CloneFromProjectID types.String `tfsdk:"clone_from_project_id"`
+}
+
 type connectivityPolicyModel struct {
 	AllowDeploymentsToNoTargets types.Bool   `tfsdk:"allow_deployments_to_no_targets"`
 	ExcludeUnhealthyTargets     types.Bool   `tfsdk:"exclude_unhealthy_targets"`
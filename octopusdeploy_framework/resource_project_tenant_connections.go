@@ -0,0 +1,442 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tagsets"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal"
+	internalErrors "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type projectTenantConnectionsResource struct {
+	*Config
+}
+
+var _ resource.ResourceWithImportState = &projectTenantConnectionsResource{}
+var _ resource.ResourceWithModifyPlan = &projectTenantConnectionsResource{}
+
+func NewProjectTenantConnectionsResource() resource.Resource {
+	return &projectTenantConnectionsResource{}
+}
+
+func (p *projectTenantConnectionsResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.ProjectTenantConnectionsResourceName)
+}
+
+func (p *projectTenantConnectionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.ProjectTenantConnectionsSchema{}.GetResourceSchema()
+}
+
+func (p *projectTenantConnectionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	p.Config = ResourceConfiguration(ctx, req, resp)
+}
+
+// ModifyPlan evaluates the tenant blocks and rules against the tenants of the space, so the tenants that are
+// connected or disconnected are shown in the plan.
+func (p *projectTenantConnectionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || p.Config == nil || p.Config.Offline {
+		return
+	}
+
+	var plan schemas.ProjectTenantConnectionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The space is unknown when it is not configured, the default space of the provider is used then
+	var configuredSpaceID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("space_id"), &configuredSpaceID)...)
+	if resp.Diagnostics.HasError() || configuredSpaceID.IsUnknown() || hasUnknownTenantConnectionInputs(plan) {
+		return
+	}
+
+	plan.SpaceID = configuredSpaceID
+	spaceID := p.getSpaceID(plan)
+	desired, diags := p.getDesiredConnections(ctx, spaceID, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connections, diags := flattenTenantConnections(ctx, desired)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("connections"), connections)...)
+}
+
+func (p *projectTenantConnectionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan schemas.ProjectTenantConnectionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (p *projectTenantConnectionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schemas.ProjectTenantConnectionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := p.getSpaceID(state)
+	projectID := state.ProjectID.ValueString()
+
+	if _, err := projects.GetByID(p.Client, spaceID, projectID); err != nil {
+		if err := internalErrors.ProcessApiErrorV2(ctx, resp, state, err, "project"); err != nil {
+			resp.Diagnostics.AddError("unable to load project", err.Error())
+		}
+		return
+	}
+
+	current, err := getProjectTenantConnections(p.Client, spaceID, projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to load the tenants connected to the project", err.Error())
+		return
+	}
+
+	connections, diags := flattenTenantConnections(ctx, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.SpaceID = types.StringValue(spaceID)
+	state.Connections = connections
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (p *projectTenantConnectionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan schemas.ProjectTenantConnectionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (p *projectTenantConnectionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	internal.Mutex.Lock()
+	defer internal.Mutex.Unlock()
+
+	var state schemas.ProjectTenantConnectionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := p.getSpaceID(state)
+	projectID := state.ProjectID.ValueString()
+
+	var managed map[string][]string
+	resp.Diagnostics.Append(state.Connections.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("disconnecting %d tenants from project (%s)", len(managed), projectID))
+
+	// Only the tenants this resource connected are disconnected
+	resp.Diagnostics.Append(updateProjectTenantConnections(ctx, p.Client, spaceID, projectID, func(tenantID string) ([]string, bool, bool) {
+		_, isManaged := managed[tenantID]
+		return nil, false, isManaged
+	})...)
+}
+
+func (p *projectTenantConnectionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	bits := util.SplitCompositeId(req.ID)
+	if len(bits) != 2 {
+		resp.Diagnostics.AddError("invalid import ID", fmt.Sprintf("expected an ID like 'Spaces-1:Projects-1', got '%s'", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_id"), bits[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), bits[1])...)
+}
+
+func (p *projectTenantConnectionsResource) getSpaceID(model schemas.ProjectTenantConnectionsResourceModel) string {
+	spaceID := model.SpaceID.ValueString()
+	if spaceID == "" {
+		spaceID = p.Client.GetSpaceID()
+	}
+	return spaceID
+}
+
+// apply connects and disconnects tenants so the project has the planned connections.
+func (p *projectTenantConnectionsResource) apply(ctx context.Context, plan *schemas.ProjectTenantConnectionsResourceModel, diags *diag.Diagnostics) {
+	internal.Mutex.Lock()
+	defer internal.Mutex.Unlock()
+
+	spaceID := p.getSpaceID(*plan)
+	projectID := plan.ProjectID.ValueString()
+
+	var desired map[string][]string
+	if plan.Connections.IsUnknown() {
+		// The plan could not be evaluated, e.g. because the tenants depended on resources created in the same apply
+		evaluated, evaluateDiags := p.getDesiredConnections(ctx, spaceID, *plan)
+		diags.Append(evaluateDiags...)
+		desired = evaluated
+	} else {
+		diags.Append(plan.Connections.ElementsAs(ctx, &desired, false)...)
+	}
+	if diags.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("connecting %d tenants to project (%s)", len(desired), projectID))
+
+	diags.Append(updateProjectTenantConnections(ctx, p.Client, spaceID, projectID, func(tenantID string) ([]string, bool, bool) {
+		environmentIDs, connect := desired[tenantID]
+		return environmentIDs, connect, !connect
+	})...)
+	if diags.HasError() {
+		return
+	}
+
+	connections, flattenDiags := flattenTenantConnections(ctx, desired)
+	diags.Append(flattenDiags...)
+
+	plan.ID = types.StringValue(util.BuildCompositeId(spaceID, projectID))
+	plan.SpaceID = types.StringValue(spaceID)
+	plan.Connections = connections
+}
+
+// getDesiredConnections evaluates the tenant blocks and rules against the tenants and tag sets of the space.
+func (p *projectTenantConnectionsResource) getDesiredConnections(ctx context.Context, spaceID string, plan schemas.ProjectTenantConnectionsResourceModel) (map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	explicit, rules, expandDiags := expandTenantConnectionInputs(ctx, plan)
+	diags.Append(expandDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	allTenants, err := tenants.GetAll(p.Client, spaceID)
+	if err != nil {
+		diags.AddError("unable to load tenants", err.Error())
+		return nil, diags
+	}
+
+	if len(rules) > 0 {
+		allTagSets, err := tagsets.GetAll(p.Client, spaceID)
+		if err != nil {
+			diags.AddError("unable to load tag sets", err.Error())
+			return nil, diags
+		}
+		if err := validateTenantConnectionRuleTags(rules, allTagSets); err != nil {
+			diags.AddAttributeError(path.Root("rule"), "unknown tenant tag", err.Error())
+			return nil, diags
+		}
+	}
+
+	desired, err := evaluateTenantConnections(allTenants, explicit, rules)
+	if err != nil {
+		diags.AddAttributeError(path.Root("tenant"), "unable to connect tenant", err.Error())
+		return nil, diags
+	}
+	return desired, diags
+}
+
+func hasUnknownTenantConnectionInputs(plan schemas.ProjectTenantConnectionsResourceModel) bool {
+	for _, value := range []attr.Value{plan.ProjectID, plan.Tenants, plan.Rules} {
+		tfValue, err := value.ToTerraformValue(context.Background())
+		if err != nil || !tfValue.IsFullyKnown() {
+			return true
+		}
+	}
+	return false
+}
+
+// tenantConnectionRule connects the tenants that match the tenant tags to the environments.
+type tenantConnectionRule struct {
+	tenantTags     []string
+	environmentIDs []string
+}
+
+func expandTenantConnectionInputs(ctx context.Context, plan schemas.ProjectTenantConnectionsResourceModel) (map[string][]string, []tenantConnectionRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var tenantModels []schemas.ProjectTenantConnectionModel
+	diags.Append(plan.Tenants.ElementsAs(ctx, &tenantModels, false)...)
+	var ruleModels []schemas.ProjectTenantConnectionRuleModel
+	diags.Append(plan.Rules.ElementsAs(ctx, &ruleModels, false)...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	explicit := map[string][]string{}
+	for _, tenant := range tenantModels {
+		tenantID := tenant.TenantID.ValueString()
+		if _, ok := explicit[tenantID]; ok {
+			diags.AddAttributeError(path.Root("tenant"), "duplicate tenant", fmt.Sprintf("the tenant '%s' is in more than one tenant block", tenantID))
+			continue
+		}
+		explicit[tenantID] = util.ExpandStringSet(tenant.EnvironmentIDs)
+	}
+
+	rules := make([]tenantConnectionRule, 0, len(ruleModels))
+	for _, rule := range ruleModels {
+		rules = append(rules, tenantConnectionRule{
+			tenantTags:     util.ExpandStringSet(rule.TenantTags),
+			environmentIDs: util.ExpandStringSet(rule.EnvironmentIDs),
+		})
+	}
+
+	return explicit, rules, diags
+}
+
+// evaluateTenantConnections returns the environment IDs each tenant is connected to, combining the tenant blocks
+// and the rules the tenant matches.
+func evaluateTenantConnections(allTenants []*tenants.Tenant, explicit map[string][]string, rules []tenantConnectionRule) (map[string][]string, error) {
+	known := map[string]bool{}
+	desired := map[string][]string{}
+
+	for _, tenant := range allTenants {
+		tenantID := tenant.GetID()
+		known[tenantID] = true
+
+		environmentIDs, connected := explicit[tenantID]
+		for _, rule := range rules {
+			if tenantMatchesTags(tenant.TenantTags, rule.tenantTags) {
+				environmentIDs = append(environmentIDs, rule.environmentIDs...)
+				connected = true
+			}
+		}
+
+		if connected {
+			environmentIDs = slices.Clone(environmentIDs)
+			slices.Sort(environmentIDs)
+			desired[tenantID] = slices.Compact(environmentIDs)
+		}
+	}
+
+	for tenantID := range explicit {
+		if !known[tenantID] {
+			return nil, fmt.Errorf("the tenant '%s' cannot be found", tenantID)
+		}
+	}
+
+	return desired, nil
+}
+
+// tenantMatchesTags reports whether the tenant has at least one of the tags of each tag set, as Octopus matches
+// tenant tags.
+func tenantMatchesTags(tenantTags []string, tags []string) bool {
+	tagsBySet := map[string][]string{}
+	for _, tag := range tags {
+		tagSet, _, _ := strings.Cut(tag, "/")
+		tagsBySet[tagSet] = append(tagsBySet[tagSet], tag)
+	}
+
+	for _, setTags := range tagsBySet {
+		if !slices.ContainsFunc(setTags, func(tag string) bool {
+			return slices.ContainsFunc(tenantTags, func(tenantTag string) bool { return strings.EqualFold(tenantTag, tag) })
+		}) {
+			return false
+		}
+	}
+	return len(tagsBySet) > 0
+}
+
+func validateTenantConnectionRuleTags(rules []tenantConnectionRule, allTagSets []*tagsets.TagSet) error {
+	known := map[string]bool{}
+	for _, tagSet := range allTagSets {
+		for _, tag := range tagSet.Tags {
+			known[strings.ToLower(tag.CanonicalTagName)] = true
+		}
+	}
+
+	for _, rule := range rules {
+		for _, tag := range rule.tenantTags {
+			if !known[strings.ToLower(tag)] {
+				return fmt.Errorf("the tenant tag '%s' cannot be found", tag)
+			}
+		}
+	}
+	return nil
+}
+
+func getProjectTenantConnections(octopus *client.Client, spaceID string, projectID string) (map[string][]string, error) {
+	connectedTenants, err := getTenantByProjectID(octopus, projectID, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	connections := map[string][]string{}
+	for _, tenant := range connectedTenants {
+		if environmentIDs, ok := tenant.ProjectEnvironments[projectID]; ok {
+			connections[tenant.GetID()] = environmentIDs
+		}
+	}
+	return connections, nil
+}
+
+// updateProjectTenantConnections updates the tenants whose connection to the project changes. For each tenant, change
+// returns the environment IDs to connect it to, whether to connect it, and whether to disconnect it.
+func updateProjectTenantConnections(ctx context.Context, octopus *client.Client, spaceID string, projectID string, change func(tenantID string) ([]string, bool, bool)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	allTenants, err := tenants.GetAll(octopus, spaceID)
+	if err != nil {
+		diags.AddError("unable to load tenants", err.Error())
+		return diags
+	}
+
+	for _, tenant := range allTenants {
+		environmentIDs, connect, disconnect := change(tenant.GetID())
+		current, isConnected := tenant.ProjectEnvironments[projectID]
+
+		switch {
+		case connect && (!isConnected || !util.StringSlicesEqual(current, environmentIDs)):
+			if tenant.ProjectEnvironments == nil {
+				tenant.ProjectEnvironments = map[string][]string{}
+			}
+			tenant.ProjectEnvironments[projectID] = environmentIDs
+			tflog.Info(ctx, fmt.Sprintf("connecting tenant (%s) to project (%s)", tenant.GetID(), projectID))
+		case disconnect && isConnected:
+			delete(tenant.ProjectEnvironments, projectID)
+			tflog.Info(ctx, fmt.Sprintf("disconnecting tenant (%s) from project (%s)", tenant.GetID(), projectID))
+		default:
+			continue
+		}
+
+		if _, err := tenants.Update(octopus, tenant); err != nil {
+			diags.AddError(fmt.Sprintf("unable to update the project connection of tenant %s", tenant.GetID()), err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func flattenTenantConnections(ctx context.Context, connections map[string][]string) (types.Map, diag.Diagnostics) {
+	return types.MapValueFrom(ctx, schemas.ProjectTenantConnectionsType.ElemType, connections)
+}
@@ -0,0 +1,61 @@
+package octopusdeploy_framework
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tagsets"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTenant(id string, tags ...string) *tenants.Tenant {
+	tenant := tenants.NewTenant(id)
+	tenant.ID = id
+	tenant.TenantTags = tags
+	return tenant
+}
+
+func TestTenantMatchesTags(t *testing.T) {
+	tenantTags := []string{"Region/EU", "Tier/Gold"}
+
+	assert.True(t, tenantMatchesTags(tenantTags, []string{"Region/EU"}))
+	assert.True(t, tenantMatchesTags(tenantTags, []string{"Region/EU", "Region/US"}), "tags of the same tag set match any")
+	assert.True(t, tenantMatchesTags(tenantTags, []string{"region/eu", "Tier/Gold"}))
+	assert.False(t, tenantMatchesTags(tenantTags, []string{"Region/EU", "Tier/Silver"}), "tags of different tag sets match all")
+	assert.False(t, tenantMatchesTags(tenantTags, nil))
+}
+
+func TestEvaluateTenantConnections(t *testing.T) {
+	allTenants := []*tenants.Tenant{
+		newTestTenant("Tenants-1", "Region/EU"),
+		newTestTenant("Tenants-2", "Region/US"),
+		newTestTenant("Tenants-3", "Region/EU", "Tier/Gold"),
+	}
+	explicit := map[string][]string{
+		"Tenants-2": {"Environments-1"},
+		"Tenants-3": {"Environments-3"},
+	}
+	rules := []tenantConnectionRule{
+		{tenantTags: []string{"Region/EU"}, environmentIDs: []string{"Environments-2", "Environments-1"}},
+	}
+
+	desired, err := evaluateTenantConnections(allTenants, explicit, rules)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"Tenants-1": {"Environments-1", "Environments-2"},
+		"Tenants-2": {"Environments-1"},
+		"Tenants-3": {"Environments-1", "Environments-2", "Environments-3"},
+	}, desired)
+
+	_, err = evaluateTenantConnections(allTenants, map[string][]string{"Tenants-9": {"Environments-1"}}, nil)
+	assert.ErrorContains(t, err, "'Tenants-9' cannot be found")
+}
+
+func TestValidateTenantConnectionRuleTags(t *testing.T) {
+	region := tagsets.NewTagSet("Region")
+	region.Tags = []*tagsets.Tag{{Name: "EU", CanonicalTagName: "Region/EU"}}
+
+	assert.NoError(t, validateTenantConnectionRuleTags([]tenantConnectionRule{{tenantTags: []string{"Region/EU"}}}, []*tagsets.TagSet{region}))
+	assert.ErrorContains(t, validateTenantConnectionRuleTags([]tenantConnectionRule{{tenantTags: []string{"Region/APAC"}}}, []*tagsets.TagSet{region}), "'Region/APAC' cannot be found")
+}
@@ -6,9 +6,11 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/credentials"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	internaltest "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/test"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -384,3 +386,62 @@ func TestExpandGitUsernamePasswordPersistenceSettings(t *testing.T) {
 	require.NotNil(t, upCred.Password.NewValue)
 	assert.Equal(t, "secret", *upCred.Password.NewValue)
 }
+
+func TestReplaceUnknownValues(t *testing.T) {
+	plan := &projectResourceModel{
+		Name:                            types.StringValue("planned"),
+		DeploymentProcessID:             types.StringUnknown(),
+		IsDisabled:                      types.BoolValue(true),
+		TenantedDeploymentParticipation: types.StringUnknown(),
+	}
+	clone := &projectResourceModel{
+		Name:                            types.StringValue("clone"),
+		DeploymentProcessID:             types.StringValue("deploymentprocess-Projects-2"),
+		IsDisabled:                      types.BoolValue(false),
+		TenantedDeploymentParticipation: types.StringValue("Tenanted"),
+	}
+
+	replaceUnknownValues(plan, clone)
+
+	assert.Equal(t, "planned", plan.Name.ValueString())
+	assert.True(t, plan.IsDisabled.ValueBool())
+	assert.Equal(t, "deploymentprocess-Projects-2", plan.DeploymentProcessID.ValueString())
+	assert.Equal(t, "Tenanted", plan.TenantedDeploymentParticipation.ValueString())
+}
+
+func TestExpandClonedProjectKeepsTemplates(t *testing.T) {
+	ctx := context.Background()
+	plan, diags := flattenProject(ctx, projects.NewProject("clone", "Lifecycles-1", "ProjectGroups-1"), &projectResourceModel{})
+	require.False(t, diags.HasError())
+	// An unconfigured template block is planned as an empty list
+	plan.Template = types.ListValueMust(types.ObjectType{AttrTypes: getTemplateAttrTypes()}, []attr.Value{})
+
+	defaultValue := core.NewPropertyValue("default", false)
+	clonedProject := projects.NewProject("clone", "Lifecycles-1", "ProjectGroups-1")
+	clonedProject.Templates = []actiontemplates.ActionTemplateParameter{
+		{Name: "Copied", DefaultValue: &defaultValue, Resource: *resources.NewResource()},
+	}
+	clonedProject.ConnectivityPolicy = &core.ConnectivityPolicy{SkipMachineBehavior: core.SkipMachineBehaviorNone}
+
+	project := expandProject(ctx, *plan)
+	assert.Empty(t, project.Templates)
+
+	keepClonedProjectSettings(project, clonedProject, *plan)
+	assert.Equal(t, clonedProject.Templates, project.Templates)
+	assert.Equal(t, clonedProject.ConnectivityPolicy, project.ConnectivityPolicy)
+
+	flattenedProject, diags := flattenProject(ctx, project, plan)
+	require.False(t, diags.HasError())
+	assert.Len(t, flattenedProject.Template.Elements(), 1)
+	keepUnconfiguredClonedBlocks(flattenedProject, *plan)
+	assert.Equal(t, plan.Template, flattenedProject.Template)
+
+	// A configured template block replaces the copied templates
+	planned := actiontemplates.ActionTemplateParameter{Name: "Planned", DefaultValue: &defaultValue, Resource: *resources.NewResource()}
+	plan.Template = flattenTemplates([]actiontemplates.ActionTemplateParameter{planned})
+
+	project = expandProject(ctx, *plan)
+	keepClonedProjectSettings(project, clonedProject, *plan)
+	require.Len(t, project.Templates, 1)
+	assert.Equal(t, "Planned", project.Templates[0].Name)
+}
@@ -40,8 +40,8 @@ func (r *projectVersioningStrategyResource) ConfigValidators(context.Context) []
 	return schemas.ProjectVersioningStrategySchema{}.GetResourceConfigValidators()
 }
 
-func (r *projectVersioningStrategyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *projectVersioningStrategyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *projectVersioningStrategyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -34,8 +34,8 @@ func (r *pyPiFeedTypeResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schemas.PyPiFeedSchema{}.GetResourceSchema()
 }
 
-func (r *pyPiFeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *pyPiFeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *pyPiFeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -34,7 +34,7 @@ func (*runbookTypeResource) Schema(ctx context.Context, req resource.SchemaReque
 }
 
 func (r *runbookTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *runbookTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -33,8 +33,8 @@ func (r *s3FeedTypeResource) Schema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schemas.S3FeedSchema{}.GetResourceSchema()
 }
 
-func (r *s3FeedTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *s3FeedTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *s3FeedTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -30,8 +30,8 @@ func (r *scopedUserRoleResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schemas.ScopedUserRoleSchema{}.GetResourceSchema()
 }
 
-func (r *scopedUserRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *scopedUserRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *scopedUserRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
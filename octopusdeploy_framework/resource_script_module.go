@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/scriptmodules"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -21,7 +24,10 @@ func NewScriptModuleResource() resource.Resource {
 	return &scriptModuleTypeResource{}
 }
 
-var _ resource.ResourceWithImportState = &scriptModuleTypeResource{}
+var (
+	_ resource.ResourceWithImportState = &scriptModuleTypeResource{}
+	_ resource.ResourceWithModifyPlan  = &scriptModuleTypeResource{}
+)
 
 func (r *scriptModuleTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = util.GetTypeName("script_module")
@@ -31,8 +37,39 @@ func (r *scriptModuleTypeResource) Schema(ctx context.Context, req resource.Sche
 	resp.Schema = schemas.ScriptModuleSchema{}.GetResourceSchema()
 }
 
-func (r *scriptModuleTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *scriptModuleTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
+}
+
+func (r *scriptModuleTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return // When deleting
+	}
+
+	var data *schemas.ScriptModuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes := data.ScriptAttributes()
+	scriptFile, ok := attributes["script_file"].(types.String)
+	if !ok {
+		return
+	}
+
+	scriptPath := path.Root("script").AtListIndex(0)
+	script, _ := attributes["body"].(types.String)
+	if !scriptFile.IsNull() {
+		var diags diag.Diagnostics
+		script, diags = readPlannedScriptFile(scriptPath.AtName("script_file"), scriptFile)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, scriptPath.AtName("script_sha256"), plannedScriptSha256(script))...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(warnAboutScriptFileChanges(ctx, req.Private, scriptPath.AtName("script_file"), scriptFile, script)...)
+	}
 }
 
 func (r *scriptModuleTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -46,6 +83,10 @@ func (r *scriptModuleTypeResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	scriptModule := schemas.MapFromScriptModuleToState(data)
+	if err := loadScriptModuleScriptFile(data, scriptModule); err != nil {
+		resp.Diagnostics.AddError("unable to create script module", err.Error())
+		return
+	}
 
 	tflog.Info(ctx, fmt.Sprintf("creating Script Module: %s", scriptModule.Name))
 
@@ -56,6 +97,7 @@ func (r *scriptModuleTypeResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	schemas.MapToScriptModuleFromState(data, createdScriptModule)
+	resp.Diagnostics.Append(setAppliedScriptModuleScript(ctx, resp.Private, data, createdScriptModule)...)
 
 	tflog.Info(ctx, fmt.Sprintf("Script Module created (%s)", data.ID))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -80,6 +122,7 @@ func (r *scriptModuleTypeResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	schemas.MapToScriptModuleFromState(data, scriptModule)
+	resp.Diagnostics.Append(setAppliedScriptModuleScript(ctx, resp.Private, data, scriptModule)...)
 
 	tflog.Info(ctx, fmt.Sprintf("Script Module read (%s)", scriptModule.GetID()))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -97,6 +140,10 @@ func (r *scriptModuleTypeResource) Update(ctx context.Context, req resource.Upda
 
 	scriptModule := schemas.MapFromScriptModuleToState(data)
 	scriptModule.ID = state.ID.ValueString()
+	if err := loadScriptModuleScriptFile(data, scriptModule); err != nil {
+		resp.Diagnostics.AddError("unable to update script module", err.Error())
+		return
+	}
 
 	updatedScriptModule, err := scriptmodules.Update(r.Config.Client, scriptModule)
 	if err != nil {
@@ -105,6 +152,7 @@ func (r *scriptModuleTypeResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	schemas.MapToScriptModuleFromState(data, updatedScriptModule)
+	resp.Diagnostics.Append(setAppliedScriptModuleScript(ctx, resp.Private, data, updatedScriptModule)...)
 
 	tflog.Info(ctx, fmt.Sprintf("Script Module updated (%s)", data.ID))
 
@@ -131,3 +179,28 @@ func (r *scriptModuleTypeResource) Delete(ctx context.Context, req resource.Dele
 func (*scriptModuleTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// loadScriptModuleScriptFile sets the body of a script module that is loaded from a script file.
+func loadScriptModuleScriptFile(data *schemas.ScriptModuleResourceModel, scriptModule *variables.ScriptModule) error {
+	attributes := data.ScriptAttributes()
+	scriptFile, ok := attributes["script_file"].(types.String)
+	if !ok || scriptFile.IsNull() {
+		return nil
+	}
+
+	plannedSha256, _ := attributes["script_sha256"].(types.String)
+	script, err := readAppliedScriptFile(scriptFile, plannedSha256)
+	if err != nil {
+		return err
+	}
+	scriptModule.ScriptBody = script
+	return nil
+}
+
+func setAppliedScriptModuleScript(ctx context.Context, private privateState, data *schemas.ScriptModuleResourceModel, scriptModule *variables.ScriptModule) diag.Diagnostics {
+	scriptFile, ok := data.ScriptAttributes()["script_file"].(types.String)
+	if !ok {
+		scriptFile = types.StringNull()
+	}
+	return setAppliedScript(ctx, private, scriptFile, scriptModule.ScriptBody)
+}
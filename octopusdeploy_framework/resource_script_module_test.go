@@ -2,11 +2,19 @@ package octopusdeploy_framework
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccOctopusDeployScriptModuleBasic(t *testing.T) {
@@ -75,3 +83,31 @@ func testScriptModuleExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestScriptModuleScriptFile(t *testing.T) {
+	scriptFile := filepath.Join(t.TempDir(), "module.ps1")
+	require.NoError(t, os.WriteFile(scriptFile, []byte("function Say-Hello {\r\n  Write-Host 'hello'\r\n}\r\n"), 0o600))
+	script := "function Say-Hello {\n  Write-Host 'hello'\n}\n"
+
+	data := &schemas.ScriptModuleResourceModel{
+		Name: types.StringValue("Greetings"),
+		Script: types.ListValueMust(schemas.ScriptModuleScriptResourceObjectType(), []attr.Value{
+			types.ObjectValueMust(schemas.ScriptModuleScriptResourceObjectType().AttrTypes, map[string]attr.Value{
+				"body":          types.StringNull(),
+				"syntax":        types.StringValue("PowerShell"),
+				"script_file":   types.StringValue(scriptFile),
+				"script_sha256": types.StringValue(util.ScriptSha256(script)),
+			}),
+		}),
+	}
+
+	scriptModule := schemas.MapFromScriptModuleToState(data)
+	require.NoError(t, loadScriptModuleScriptFile(data, scriptModule))
+	assert.Equal(t, script, scriptModule.ScriptBody, "the body is loaded from the script file with normalised line endings")
+
+	schemas.MapToScriptModuleFromState(data, scriptModule)
+	attributes := data.ScriptAttributes()
+	assert.True(t, attributes["body"].IsNull(), "the body of a script file is not kept in the state")
+	assert.Equal(t, types.StringValue(scriptFile), attributes["script_file"])
+	assert.Equal(t, types.StringValue(util.ScriptSha256(script)), attributes["script_sha256"])
+}
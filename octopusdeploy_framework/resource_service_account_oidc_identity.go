@@ -28,8 +28,8 @@ func (s *ServiceAccountOIDCIdentity) Schema(_ context.Context, _ resource.Schema
 	resp.Schema = schemas.ServiceAccountOIDCIdentitySchema{}.GetResourceSchema()
 }
 
-func (s *ServiceAccountOIDCIdentity) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	s.Config = ResourceConfiguration(req, resp)
+func (s *ServiceAccountOIDCIdentity) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	s.Config = ResourceConfiguration(ctx, req, resp)
 }
 func (s *ServiceAccountOIDCIdentity) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan schemas.OIDCServiceAccountSchemaModel
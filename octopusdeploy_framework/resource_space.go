@@ -37,8 +37,8 @@ func (s *spaceResource) Metadata(_ context.Context, _ resource.MetadataRequest,
 	resp.TypeName = util.GetTypeName("space")
 }
 
-func (s *spaceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	s.Config = ResourceConfiguration(req, resp)
+func (s *spaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	s.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (s *spaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -25,8 +25,8 @@ func (s *spaceDefaultLifecycleReleaseRetentionPolicyResource) Schema(_ context.C
 	resp.Schema = schemas.SpaceDefaultLifecycleReleaseRetentionPolicySchema{}.GetResourceSchema()
 }
 
-func (s *spaceDefaultLifecycleReleaseRetentionPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	s.Config = ResourceConfiguration(req, resp)
+func (s *spaceDefaultLifecycleReleaseRetentionPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	s.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 // Metadata implements resource.Resource.
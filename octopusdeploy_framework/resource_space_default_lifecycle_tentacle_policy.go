@@ -25,8 +25,8 @@ func (s *spaceDefaultLifecycleTentacleRetentionPolicyResource) Schema(_ context.
 	resp.Schema = schemas.SpaceDefaultLifecycleTentacleRetentionPolicySchema{}.GetResourceSchema()
 }
 
-func (s *spaceDefaultLifecycleTentacleRetentionPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	s.Config = ResourceConfiguration(req, resp)
+func (s *spaceDefaultLifecycleTentacleRetentionPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	s.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 // Metadata implements resource.Resource.
@@ -25,8 +25,8 @@ func (s *spaceDefaultRunbookRetentionPolicyResource) Schema(_ context.Context, _
 	resp.Schema = schemas.SpaceDefaultRunbookRetentionPolicySchema{}.GetResourceSchema()
 }
 
-func (s *spaceDefaultRunbookRetentionPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	s.Config = ResourceConfiguration(req, resp)
+func (s *spaceDefaultRunbookRetentionPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	s.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 // Metadata implements resource.Resource.
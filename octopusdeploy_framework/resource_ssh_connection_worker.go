@@ -32,8 +32,8 @@ func (r *sshConnectionWorkerResource) Schema(ctx context.Context, req resource.S
 	resp.Schema = schemas.SSHConnectionWorkerSchema{}.GetResourceSchema()
 }
 
-func (r *sshConnectionWorkerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *sshConnectionWorkerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *sshConnectionWorkerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
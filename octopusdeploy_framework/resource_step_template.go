@@ -39,8 +39,8 @@ func (r *stepTemplateTypeResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schemas.StepTemplateSchema{}.GetResourceSchema()
 }
 
-func (r *stepTemplateTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *stepTemplateTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (*stepTemplateTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -36,8 +36,8 @@ func (r *tagTypeResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schemas.TagSchema{}.GetResourceSchema()
 }
 
-func (r *tagTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *tagTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *tagTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -31,8 +31,8 @@ func (r *tagSetResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	resp.Schema = schemas.TagSetSchema{}.GetResourceSchema()
 }
 
-func (r *tagSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *tagSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *tagSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
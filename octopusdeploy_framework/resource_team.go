@@ -32,8 +32,8 @@ func (r *teamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 	resp.Schema = schemas.TeamSchema{}.GetResourceSchema()
 }
 
-func (r *teamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *teamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
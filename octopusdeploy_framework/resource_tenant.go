@@ -9,6 +9,7 @@ import (
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -25,6 +26,7 @@ func NewTenantResource() resource.Resource {
 }
 
 var _ resource.ResourceWithImportState = &tenantTypeResource{}
+var _ resource.ResourceWithModifyPlan = &tenantTypeResource{}
 
 func (r *tenantTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = util.GetTypeName("tenant")
@@ -34,8 +36,24 @@ func (r *tenantTypeResource) Schema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schemas.TenantSchema{}.GetResourceSchema()
 }
 
-func (r *tenantTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *tenantTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
+}
+
+// ModifyPlan plans the tenant being cloned as cloned_from_tenant_id, unless cloned_from_tenant_id is configured.
+func (r *tenantTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var cloneFromTenantID, configuredClonedFromTenantID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("clone_from_tenant_id"), &cloneFromTenantID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cloned_from_tenant_id"), &configuredClonedFromTenantID)...)
+	if resp.Diagnostics.HasError() || cloneFromTenantID.IsNull() || !configuredClonedFromTenantID.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cloned_from_tenant_id"), cloneFromTenantID)...)
 }
 
 func (r *tenantTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -53,12 +71,28 @@ func (r *tenantTypeResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	tflog.Info(ctx, fmt.Sprintf("creating Tenant: %s", tenant.Name))
+	var createdTenant *tenants.Tenant
+	if sourceTenantID := getSourceTenantID(data); sourceTenantID != "" {
+		tflog.Info(ctx, fmt.Sprintf("cloning Tenant (%s): %s", sourceTenantID, tenant.Name))
+
+		createdTenant, err = r.cloneTenant(ctx, data, tenant, sourceTenantID)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to clone tenant", err.Error())
+			if createdTenant != nil {
+				// keep the tenant in the state, so that it is tainted and replaced rather than left behind
+				mapTenantToState(ctx, data, createdTenant)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			}
+			return
+		}
+	} else {
+		tflog.Info(ctx, fmt.Sprintf("creating Tenant: %s", tenant.Name))
 
-	createdTenant, err := tenants.Add(r.Config.Client, tenant)
-	if err != nil {
-		resp.Diagnostics.AddError("unable to create tenant", err.Error())
-		return
+		createdTenant, err = tenants.Add(r.Config.Client, tenant)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to create tenant", err.Error())
+			return
+		}
 	}
 
 	mapTenantToState(ctx, data, createdTenant)
@@ -146,6 +180,66 @@ func (r *tenantTypeResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+// getSourceTenantID returns the ID of the tenant to copy when the tenant is created, if any.
+func getSourceTenantID(data *schemas.TenantModel) string {
+	if !data.CloneFromTenantID.IsNull() {
+		return data.CloneFromTenantID.ValueString()
+	}
+	return data.TemplateTenantID.ValueString()
+}
+
+// cloneTenant clones the source tenant, which copies its project connections, tenant tags and variable values, and
+// then updates the clone with the planned values. The tenant tags of the source tenant are kept when they are not
+// configured. The clone is returned along with an error when the server did not keep the planned cloned_from_tenant_id.
+func (r *tenantTypeResource) cloneTenant(ctx context.Context, data *schemas.TenantModel, tenant *tenants.Tenant, sourceTenantID string) (*tenants.Tenant, error) {
+	sourceTenant, err := tenants.GetByID(r.Config.Client, data.SpaceID.ValueString(), sourceTenantID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load tenant %s: %w", sourceTenantID, err)
+	}
+
+	clonedTenant, err := r.Config.Client.Tenants.Clone(sourceTenant, tenants.TenantCloneRequest{
+		Name:        tenant.Name,
+		Description: tenant.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tenant.ID = clonedTenant.GetID()
+	tenant.SpaceID = clonedTenant.SpaceID
+	tenant.ProjectEnvironments = clonedTenant.ProjectEnvironments
+	if data.TenantTags.IsUnknown() {
+		tenant.TenantTags = clonedTenant.TenantTags
+	}
+	if data.IsDisabled.IsUnknown() {
+		tenant.IsDisabled = clonedTenant.IsDisabled
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Tenant cloned (%s), applying overrides", tenant.ID))
+
+	// The clone API always marks the tenant as a clone, which the update clears for a copy of a template tenant
+	tenant.ClonedFromTenantID = data.ClonedFromTenantId.ValueString()
+	updatedTenant, err := tenants.Update(r.Config.Client, tenant)
+	if err != nil {
+		return clonedTenant, err
+	}
+
+	return updatedTenant, checkClonedFromTenantID(updatedTenant, tenant.ClonedFromTenantID)
+}
+
+// checkClonedFromTenantID returns an error when the server did not keep the tenant that the tenant was planned to be a
+// clone of.
+func checkClonedFromTenantID(tenant *tenants.Tenant, clonedFromTenantID string) error {
+	if tenant.ClonedFromTenantID == clonedFromTenantID {
+		return nil
+	}
+
+	if clonedFromTenantID == "" {
+		return fmt.Errorf("the Octopus Server kept tenant %s marked as a clone of tenant %s. Use clone_from_tenant_id instead of template_tenant_id with this server", tenant.GetID(), tenant.ClonedFromTenantID)
+	}
+	return fmt.Errorf("the Octopus Server marked tenant %s as a clone of tenant '%s' rather than tenant %s", tenant.GetID(), tenant.ClonedFromTenantID, clonedFromTenantID)
+}
+
 func mapStateToTenant(ctx context.Context, data *schemas.TenantModel) (*tenants.Tenant, error) {
 	tenant := tenants.NewTenant(data.Name.ValueString())
 	tenant.ID = data.ID.ValueString()
@@ -172,9 +266,14 @@ func mapTenantToState(ctx context.Context, data *schemas.TenantModel, tenant *te
 	data.SpaceID = types.StringValue(tenant.SpaceID)
 	data.Name = types.StringValue(tenant.Name)
 
-	convertedTenantTags, diags := util.SetToStringArray(ctx, data.TenantTags)
-	if diags.HasError() {
-		tflog.Error(ctx, fmt.Sprintf("Error converting tenant tags: %v\n", diags))
+	// Tenant tags are unknown when a tenant is cloned without configuring them, the tags are copied from the source tenant then
+	convertedTenantTags := tenant.TenantTags
+	if !data.TenantTags.IsUnknown() {
+		var diags diag.Diagnostics
+		convertedTenantTags, diags = util.SetToStringArray(ctx, data.TenantTags)
+		if diags.HasError() {
+			tflog.Error(ctx, fmt.Sprintf("Error converting tenant tags: %v\n", diags))
+		}
 	}
 
 	data.TenantTags = basetypes.SetValue(util.FlattenStringList(convertedTenantTags))
@@ -20,6 +20,7 @@ import (
 
 var _ resource.Resource = &tenantCommonVariableResource{}
 var _ resource.ResourceWithImportState = &tenantCommonVariableResource{}
+var _ resource.ResourceWithModifyPlan = &tenantCommonVariableResource{}
 
 type tenantCommonVariableResource struct {
 	*Config
@@ -52,11 +53,23 @@ func (t *tenantCommonVariableResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schemas.GetTenantCommonVariableResourceSchema()
 }
 
-func (t *tenantCommonVariableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	t.Config = ResourceConfiguration(req, resp)
+func (t *tenantCommonVariableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	t.Config = ResourceConfiguration(ctx, req, resp)
+}
+
+func (t *tenantCommonVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(t.Config.EnsureAttributeCompatibility(ctx, schemas.TenantCommonVariableResourceName, req.Config)...)
 }
 
 func (t *tenantCommonVariableResource) supportsV2() bool {
+	if t.Config != nil && t.Config.Offline {
+		// Nothing to check against until the provider configuration is known, the server rejects scopes it does not support
+		return true
+	}
 	if t.Config == nil || t.Config.FeatureToggles == nil {
 		// If we can't check feature toggles, the server is too old for V2
 		return false
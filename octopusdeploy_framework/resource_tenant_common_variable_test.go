@@ -7,9 +7,13 @@ import (
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
 
 	internalTest "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/test"
 )
@@ -614,3 +618,23 @@ func testAccTenantCommonVariableCheckDestroyV2(s *terraform.State) error {
 
 	return nil
 }
+
+// TestTenantCommonVariableScopeSupport checks that a scope is rejected on apply when the server's feature toggles can't
+// be read, as the V1 API that is used then has no scopes.
+func TestTenantCommonVariableScopeSupport(t *testing.T) {
+	scope := []tenantCommonVariableScopeModel{{EnvironmentIDs: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("Environments-1")})}}
+
+	diags := diag.Diagnostics{}
+	unknownToggles := &tenantCommonVariableResource{Config: &Config{}}
+	assert.False(t, unknownToggles.validateScopeSupport(scope, &diags))
+	assert.True(t, diags.HasError())
+
+	diags = diag.Diagnostics{}
+	assert.True(t, unknownToggles.validateScopeSupport(nil, &diags), "a variable without a scope uses the V1 API")
+	assert.False(t, diags.HasError())
+
+	diags = diag.Diagnostics{}
+	scopingEnabled := &tenantCommonVariableResource{Config: &Config{FeatureToggles: map[string]bool{"CommonVariableScopingFeatureToggle": true}}}
+	assert.True(t, scopingEnabled.validateScopeSupport(scope, &diags))
+	assert.False(t, diags.HasError())
+}
@@ -38,8 +38,8 @@ func (t *tenantProjectResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schemas.TenantProjectsSchema{}.GetResourceSchema()
 }
 
-func (t *tenantProjectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	t.Config = ResourceConfiguration(req, resp)
+func (t *tenantProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	t.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (t *tenantProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -20,6 +20,7 @@ import (
 
 var _ resource.Resource = &tenantProjectVariableResource{}
 var _ resource.ResourceWithImportState = &tenantProjectVariableResource{}
+var _ resource.ResourceWithModifyPlan = &tenantProjectVariableResource{}
 var _ resource.ResourceWithConfigValidators = &tenantProjectVariableResource{}
 
 type tenantProjectVariableResource struct {
@@ -54,8 +55,8 @@ func (t *tenantProjectVariableResource) Schema(_ context.Context, _ resource.Sch
 	resp.Schema = schemas.TenantProjectVariableSchema{}.GetResourceSchema()
 }
 
-func (t *tenantProjectVariableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	t.Config = ResourceConfiguration(req, resp)
+func (t *tenantProjectVariableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	t.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (t *tenantProjectVariableResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -64,7 +65,19 @@ func (t *tenantProjectVariableResource) ConfigValidators(ctx context.Context) []
 	}
 }
 
+func (t *tenantProjectVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(t.Config.EnsureAttributeCompatibility(ctx, schemas.TenantProjectVariableResourceName, req.Config)...)
+}
+
 func (t *tenantProjectVariableResource) supportsV2() bool {
+	if t.Config != nil && t.Config.Offline {
+		// Nothing to check against until the provider configuration is known, the server rejects scopes it does not support
+		return true
+	}
 	if t.Config == nil || t.Config.FeatureToggles == nil {
 		// If we can't check feature toggles, the server is too old for V2
 		return false
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccTenantBasic(t *testing.T) {
@@ -77,6 +78,53 @@ func testAccTenantBasic(lifecycleLocalName string, lifecycleName string, project
 	}`, localName, description, name, isDisabled, localName, projectLocalName, environmentLocalName)
 }
 
+func TestAccTenantClone(t *testing.T) {
+	sourceLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	sourceName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	cloneLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	cloneName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	copyLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	copyName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	cloneResourceName := "octopusdeploy_tenant." + cloneLocalName
+	copyResourceName := "octopusdeploy_tenant." + copyLocalName
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:             testAccTenantCheckDestroy,
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testTenantExists(cloneResourceName),
+					resource.TestCheckResourceAttr(cloneResourceName, "name", cloneName),
+					resource.TestCheckResourceAttrPair(cloneResourceName, "cloned_from_tenant_id", "octopusdeploy_tenant."+sourceLocalName, "id"),
+					testTenantExists(copyResourceName),
+					resource.TestCheckResourceAttr(copyResourceName, "name", copyName),
+					resource.TestCheckResourceAttr(copyResourceName, "cloned_from_tenant_id", ""),
+				),
+				Config: testAccTenantClone(sourceLocalName, sourceName, cloneLocalName, cloneName, copyLocalName, copyName),
+			},
+		},
+	})
+}
+
+func testAccTenantClone(sourceLocalName string, sourceName string, cloneLocalName string, cloneName string, copyLocalName string, copyName string) string {
+	return fmt.Sprintf(`
+	resource "octopusdeploy_tenant" "%s" {
+		name = "%s"
+	}
+
+	resource "octopusdeploy_tenant" "%s" {
+		name                 = "%s"
+		clone_from_tenant_id = octopusdeploy_tenant.%s.id
+	}
+
+	resource "octopusdeploy_tenant" "%s" {
+		name               = "%s"
+		template_tenant_id = octopusdeploy_tenant.%s.id
+	}`, sourceLocalName, sourceName, cloneLocalName, cloneName, sourceLocalName, copyLocalName, copyName, sourceLocalName)
+}
+
 func testTenantExists(prefix string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// find the corresponding state object
@@ -106,3 +154,14 @@ func testAccTenantCheckDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestCheckClonedFromTenantID(t *testing.T) {
+	copied := tenants.NewTenant("Copy")
+	copied.ID = "Tenants-2"
+	assert.NoError(t, checkClonedFromTenantID(copied, ""))
+
+	copied.ClonedFromTenantID = "Tenants-1"
+	assert.ErrorContains(t, checkClonedFromTenantID(copied, ""), "kept tenant Tenants-2 marked as a clone of tenant Tenants-1")
+	assert.NoError(t, checkClonedFromTenantID(copied, "Tenants-1"))
+	assert.ErrorContains(t, checkClonedFromTenantID(copied, "Tenants-3"), "rather than tenant Tenants-3")
+}
@@ -0,0 +1,502 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal"
+	internalErrors "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type tenantVariablesResource struct {
+	*Config
+}
+
+var _ resource.ResourceWithImportState = &tenantVariablesResource{}
+var _ resource.ResourceWithModifyPlan = &tenantVariablesResource{}
+
+func NewTenantVariablesResource() resource.Resource {
+	return &tenantVariablesResource{}
+}
+
+func (t *tenantVariablesResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.GetTypeName(schemas.TenantVariablesResourceName)
+}
+
+func (t *tenantVariablesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schemas.TenantVariablesSchema{}.GetResourceSchema()
+}
+
+func (t *tenantVariablesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	t.Config = ResourceConfiguration(ctx, req, resp)
+}
+
+// ModifyPlan validates the values against the variable templates of the tenant, so unknown templates and
+// templates without a value are reported before anything is applied.
+func (t *tenantVariablesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || t.Config == nil || t.Config.Offline {
+		return
+	}
+
+	var plan schemas.TenantVariablesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The space is unknown when it is not configured, the default space of the provider is used then
+	var configuredSpaceID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("space_id"), &configuredSpaceID)...)
+	if resp.Diagnostics.HasError() || configuredSpaceID.IsUnknown() || plan.TenantID.IsUnknown() {
+		return
+	}
+
+	values, diags := expandTenantVariableValues(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || values.hasUnknownKeys {
+		return
+	}
+
+	plan.SpaceID = configuredSpaceID
+	tenant, err := tenants.GetByID(t.Client, t.getSpaceID(plan), plan.TenantID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("tenant_id"), "unable to load tenant", err.Error())
+		return
+	}
+
+	tenantVariables, err := t.Client.Tenants.GetVariables(tenant)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to load tenant variables", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(validateTenantVariableValues(tenantVariables, values)...)
+}
+
+func (t *tenantVariablesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan schemas.TenantVariablesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	t.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (t *tenantVariablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schemas.TenantVariablesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	internal.KeyedMutex.Lock(state.TenantID.ValueString())
+	defer internal.KeyedMutex.Unlock(state.TenantID.ValueString())
+
+	tenant, err := tenants.GetByID(t.Client, t.getSpaceID(state), state.TenantID.ValueString())
+	if err != nil {
+		if err := internalErrors.ProcessApiErrorV2(ctx, resp, state, err, "tenant"); err != nil {
+			resp.Diagnostics.AddError("unable to load tenant", err.Error())
+		}
+		return
+	}
+
+	tenantVariables, err := t.Client.Tenants.GetVariables(tenant)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to load tenant variables", err.Error())
+		return
+	}
+
+	prior, diags := expandTenantVariableValues(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := flattenTenantVariableValues(tenantVariables, prior)
+	resp.Diagnostics.Append(setTenantVariableValues(ctx, &state, current)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(tenant.GetID())
+	state.SpaceID = types.StringValue(tenant.SpaceID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (t *tenantVariablesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan schemas.TenantVariablesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	t.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (t *tenantVariablesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state schemas.TenantVariablesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	internal.KeyedMutex.Lock(state.TenantID.ValueString())
+	defer internal.KeyedMutex.Unlock(state.TenantID.ValueString())
+
+	tenant, err := tenants.GetByID(t.Client, t.getSpaceID(state), state.TenantID.ValueString())
+	if err != nil {
+		if apiError, ok := err.(*core.APIError); ok && apiError.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("unable to load tenant", err.Error())
+		return
+	}
+
+	tenantVariables, err := t.Client.Tenants.GetVariables(tenant)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to load tenant variables", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("removing the variable values of tenant (%s)", tenant.GetID()))
+
+	replaceTenantVariableValues(tenantVariables, tenantVariableValues{})
+	if _, err := t.Client.Tenants.UpdateVariables(tenant, tenantVariables); err != nil {
+		resp.Diagnostics.AddError("unable to update tenant variables", err.Error())
+	}
+}
+
+func (t *tenantVariablesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), req.ID)...)
+}
+
+func (t *tenantVariablesResource) getSpaceID(model schemas.TenantVariablesResourceModel) string {
+	spaceID := model.SpaceID.ValueString()
+	if spaceID == "" {
+		spaceID = t.Client.GetSpaceID()
+	}
+	return spaceID
+}
+
+// apply replaces all the variable values of the tenant with the planned values in a single update.
+func (t *tenantVariablesResource) apply(ctx context.Context, plan *schemas.TenantVariablesResourceModel, diags *diag.Diagnostics) {
+	internal.KeyedMutex.Lock(plan.TenantID.ValueString())
+	defer internal.KeyedMutex.Unlock(plan.TenantID.ValueString())
+
+	values, expandDiags := expandTenantVariableValues(ctx, *plan)
+	diags.Append(expandDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	tenant, err := tenants.GetByID(t.Client, t.getSpaceID(*plan), plan.TenantID.ValueString())
+	if err != nil {
+		diags.AddError("unable to load tenant", err.Error())
+		return
+	}
+
+	tenantVariables, err := t.Client.Tenants.GetVariables(tenant)
+	if err != nil {
+		diags.AddError("unable to load tenant variables", err.Error())
+		return
+	}
+
+	// The templates are validated again, the plan could not be validated when the tenant was not known yet
+	diags.Append(validateTenantVariableValues(tenantVariables, values)...)
+	if diags.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("updating %d variable values of tenant (%s)", len(values.common)+len(values.project), tenant.GetID()))
+
+	replaceTenantVariableValues(tenantVariables, values)
+	if _, err := t.Client.Tenants.UpdateVariables(tenant, tenantVariables); err != nil {
+		diags.AddError("unable to update tenant variables", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(tenant.GetID())
+	plan.SpaceID = types.StringValue(tenant.SpaceID)
+}
+
+// tenantVariableKey identifies a variable value of a tenant. The owner is the library variable set of a common
+// variable or the project of a project variable, the environment is only set for project variables.
+type tenantVariableKey struct {
+	ownerID       string
+	environmentID string
+	templateID    string
+}
+
+type tenantVariableValues struct {
+	common         map[tenantVariableKey]types.String
+	project        map[tenantVariableKey]types.String
+	hasUnknownKeys bool
+}
+
+func expandTenantVariableValues(ctx context.Context, model schemas.TenantVariablesResourceModel) (tenantVariableValues, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	values := tenantVariableValues{
+		common:         map[tenantVariableKey]types.String{},
+		project:        map[tenantVariableKey]types.String{},
+		hasUnknownKeys: model.CommonVariables.IsUnknown() || model.ProjectVariables.IsUnknown(),
+	}
+
+	var commonModels []schemas.TenantVariablesCommonVariableModel
+	diags.Append(model.CommonVariables.ElementsAs(ctx, &commonModels, false)...)
+	var projectModels []schemas.TenantVariablesProjectVariableModel
+	diags.Append(model.ProjectVariables.ElementsAs(ctx, &projectModels, false)...)
+	if diags.HasError() {
+		return values, diags
+	}
+
+	for _, v := range commonModels {
+		if v.LibraryVariableSetID.IsUnknown() || v.TemplateID.IsUnknown() {
+			values.hasUnknownKeys = true
+			continue
+		}
+		key := tenantVariableKey{ownerID: v.LibraryVariableSetID.ValueString(), templateID: v.TemplateID.ValueString()}
+		if _, ok := values.common[key]; ok {
+			diags.AddAttributeError(path.Root("common_variable"), "duplicate common variable", fmt.Sprintf("the template '%s' of library variable set '%s' has more than one value", key.templateID, key.ownerID))
+			continue
+		}
+		values.common[key] = v.Value
+	}
+
+	for _, v := range projectModels {
+		if v.ProjectID.IsUnknown() || v.EnvironmentID.IsUnknown() || v.TemplateID.IsUnknown() {
+			values.hasUnknownKeys = true
+			continue
+		}
+		key := tenantVariableKey{ownerID: v.ProjectID.ValueString(), environmentID: v.EnvironmentID.ValueString(), templateID: v.TemplateID.ValueString()}
+		if _, ok := values.project[key]; ok {
+			diags.AddAttributeError(path.Root("project_variable"), "duplicate project variable", fmt.Sprintf("the template '%s' of project '%s' has more than one value in environment '%s'", key.templateID, key.ownerID, key.environmentID))
+			continue
+		}
+		values.project[key] = v.Value
+	}
+
+	return values, diags
+}
+
+// validateTenantVariableValues checks every value belongs to a template of the tenant, and every template
+// without a default value has a value.
+func validateTenantVariableValues(tenantVariables *variables.TenantVariables, values tenantVariableValues) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for key := range values.common {
+		libraryVariable, ok := tenantVariables.LibraryVariables[key.ownerID]
+		if !ok {
+			diags.AddAttributeError(path.Root("common_variable"), "unknown library variable set", fmt.Sprintf("the library variable set '%s' is not included in a project the tenant is connected to", key.ownerID))
+		} else if findTenantVariableTemplate(libraryVariable.Templates, key.templateID) == nil {
+			diags.AddAttributeError(path.Root("common_variable"), "unknown common variable template", fmt.Sprintf("the template '%s' cannot be found in library variable set '%s'", key.templateID, key.ownerID))
+		}
+	}
+
+	for key := range values.project {
+		projectVariable, ok := tenantVariables.ProjectVariables[key.ownerID]
+		if !ok {
+			diags.AddAttributeError(path.Root("project_variable"), "unknown project", fmt.Sprintf("the tenant is not connected to project '%s'", key.ownerID))
+		} else if _, ok := projectVariable.Variables[key.environmentID]; !ok {
+			diags.AddAttributeError(path.Root("project_variable"), "unknown environment", fmt.Sprintf("the tenant is not connected to project '%s' in environment '%s'", key.ownerID, key.environmentID))
+		} else if findTenantVariableTemplate(projectVariable.Templates, key.templateID) == nil {
+			diags.AddAttributeError(path.Root("project_variable"), "unknown project variable template", fmt.Sprintf("the template '%s' cannot be found in project '%s'", key.templateID, key.ownerID))
+		}
+	}
+
+	for _, libraryVariableSetID := range slices.Sorted(maps.Keys(tenantVariables.LibraryVariables)) {
+		libraryVariable := tenantVariables.LibraryVariables[libraryVariableSetID]
+		for _, template := range libraryVariable.Templates {
+			key := tenantVariableKey{ownerID: libraryVariableSetID, templateID: template.GetID()}
+			if isTenantVariableTemplateRequired(template) && !hasTenantVariableValue(values.common, key) {
+				diags.AddAttributeError(path.Root("common_variable"), "missing common variable value", fmt.Sprintf("the template '%s' (%s) of library variable set '%s' has no default value and needs a value", template.Name, key.templateID, libraryVariableSetID))
+			}
+		}
+	}
+
+	for _, projectID := range slices.Sorted(maps.Keys(tenantVariables.ProjectVariables)) {
+		projectVariable := tenantVariables.ProjectVariables[projectID]
+		for _, environmentID := range slices.Sorted(maps.Keys(projectVariable.Variables)) {
+			for _, template := range projectVariable.Templates {
+				key := tenantVariableKey{ownerID: projectID, environmentID: environmentID, templateID: template.GetID()}
+				if isTenantVariableTemplateRequired(template) && !hasTenantVariableValue(values.project, key) {
+					diags.AddAttributeError(path.Root("project_variable"), "missing project variable value", fmt.Sprintf("the template '%s' (%s) of project '%s' has no default value and needs a value in environment '%s'", template.Name, key.templateID, projectID, environmentID))
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+func findTenantVariableTemplate(templates []*actiontemplates.ActionTemplateParameter, templateID string) *actiontemplates.ActionTemplateParameter {
+	for _, template := range templates {
+		if template.GetID() == templateID {
+			return template
+		}
+	}
+	return nil
+}
+
+func isTenantVariableTemplateRequired(template *actiontemplates.ActionTemplateParameter) bool {
+	defaultValue := template.DefaultValue
+	if defaultValue == nil {
+		return true
+	}
+	if defaultValue.IsSensitive {
+		return defaultValue.SensitiveValue == nil || !defaultValue.SensitiveValue.HasValue
+	}
+	return defaultValue.Value == ""
+}
+
+// hasTenantVariableValue treats an unknown value as a value, it is only known once applied.
+func hasTenantVariableValue(values map[tenantVariableKey]types.String, key tenantVariableKey) bool {
+	value, ok := values[key]
+	return ok && (value.IsUnknown() || value.ValueString() != "")
+}
+
+// replaceTenantVariableValues removes all the values of the tenant variables and sets the given values. The values
+// have been validated against the templates.
+func replaceTenantVariableValues(tenantVariables *variables.TenantVariables, values tenantVariableValues) {
+	for libraryVariableSetID, libraryVariable := range tenantVariables.LibraryVariables {
+		libraryVariable.Variables = map[string]core.PropertyValue{}
+		for _, template := range libraryVariable.Templates {
+			key := tenantVariableKey{ownerID: libraryVariableSetID, templateID: template.GetID()}
+			if value, ok := values.common[key]; ok {
+				libraryVariable.Variables[key.templateID] = core.NewPropertyValue(value.ValueString(), isTemplateControlTypeSensitive(template.DisplaySettings))
+			}
+		}
+		tenantVariables.LibraryVariables[libraryVariableSetID] = libraryVariable
+	}
+
+	for projectID, projectVariable := range tenantVariables.ProjectVariables {
+		for environmentID := range projectVariable.Variables {
+			environment := map[string]core.PropertyValue{}
+			for _, template := range projectVariable.Templates {
+				key := tenantVariableKey{ownerID: projectID, environmentID: environmentID, templateID: template.GetID()}
+				if value, ok := values.project[key]; ok {
+					environment[key.templateID] = core.NewPropertyValue(value.ValueString(), isTemplateControlTypeSensitive(template.DisplaySettings))
+				}
+			}
+			projectVariable.Variables[environmentID] = environment
+		}
+		tenantVariables.ProjectVariables[projectID] = projectVariable
+	}
+}
+
+// flattenTenantVariableValues returns the values of the tenant variables. Sensitive values are not returned by the
+// server, so the prior value is kept when the server has a value.
+func flattenTenantVariableValues(tenantVariables *variables.TenantVariables, prior tenantVariableValues) tenantVariableValues {
+	current := tenantVariableValues{
+		common:  map[tenantVariableKey]types.String{},
+		project: map[tenantVariableKey]types.String{},
+	}
+
+	for libraryVariableSetID, libraryVariable := range tenantVariables.LibraryVariables {
+		for templateID, value := range libraryVariable.Variables {
+			key := tenantVariableKey{ownerID: libraryVariableSetID, templateID: templateID}
+			if flattened, ok := flattenTenantVariableValue(value, prior.common[key]); ok {
+				current.common[key] = flattened
+			}
+		}
+	}
+
+	for projectID, projectVariable := range tenantVariables.ProjectVariables {
+		for environmentID, environment := range projectVariable.Variables {
+			for templateID, value := range environment {
+				key := tenantVariableKey{ownerID: projectID, environmentID: environmentID, templateID: templateID}
+				if flattened, ok := flattenTenantVariableValue(value, prior.project[key]); ok {
+					current.project[key] = flattened
+				}
+			}
+		}
+	}
+
+	return current
+}
+
+func flattenTenantVariableValue(value core.PropertyValue, prior types.String) (types.String, bool) {
+	if !value.IsSensitive {
+		return types.StringValue(value.Value), true
+	}
+	if value.SensitiveValue == nil || !value.SensitiveValue.HasValue {
+		return types.String{}, false
+	}
+	if prior.IsNull() || prior.IsUnknown() {
+		// The value cannot be read, e.g. when the resource is imported, so it is updated by the next apply
+		return types.StringValue(""), true
+	}
+	return prior, true
+}
+
+func setTenantVariableValues(ctx context.Context, model *schemas.TenantVariablesResourceModel, values tenantVariableValues) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	commonModels := make([]schemas.TenantVariablesCommonVariableModel, 0, len(values.common))
+	for _, key := range sortedTenantVariableKeys(values.common) {
+		commonModels = append(commonModels, schemas.TenantVariablesCommonVariableModel{
+			LibraryVariableSetID: types.StringValue(key.ownerID),
+			TemplateID:           types.StringValue(key.templateID),
+			Value:                values.common[key],
+		})
+	}
+
+	projectModels := make([]schemas.TenantVariablesProjectVariableModel, 0, len(values.project))
+	for _, key := range sortedTenantVariableKeys(values.project) {
+		projectModels = append(projectModels, schemas.TenantVariablesProjectVariableModel{
+			ProjectID:     types.StringValue(key.ownerID),
+			EnvironmentID: types.StringValue(key.environmentID),
+			TemplateID:    types.StringValue(key.templateID),
+			Value:         values.project[key],
+		})
+	}
+
+	commonVariables, setDiags := types.SetValueFrom(ctx, schemas.TenantVariablesCommonVariableObjectType(), commonModels)
+	diags.Append(setDiags...)
+	projectVariables, setDiags := types.SetValueFrom(ctx, schemas.TenantVariablesProjectVariableObjectType(), projectModels)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.CommonVariables = commonVariables
+	model.ProjectVariables = projectVariables
+	return diags
+}
+
+func sortedTenantVariableKeys(values map[tenantVariableKey]types.String) []tenantVariableKey {
+	keys := make([]tenantVariableKey, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b tenantVariableKey) int {
+		return strings.Compare(a.ownerID+"/"+a.environmentID+"/"+a.templateID, b.ownerID+"/"+b.environmentID+"/"+b.templateID)
+	})
+	return keys
+}
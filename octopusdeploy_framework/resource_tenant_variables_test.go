@@ -0,0 +1,126 @@
+package octopusdeploy_framework
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/actiontemplates"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTemplate(id string, defaultValue *core.PropertyValue, sensitive bool) *actiontemplates.ActionTemplateParameter {
+	template := actiontemplates.NewActionTemplateParameter()
+	template.ID = id
+	template.Name = id
+	template.DefaultValue = defaultValue
+	if sensitive {
+		template.DisplaySettings = map[string]string{"Octopus.ControlType": "Sensitive"}
+	}
+	return template
+}
+
+func newTestTenantVariables() *variables.TenantVariables {
+	withDefault := core.NewPropertyValue("default", false)
+	tenantVariables := variables.NewTenantVariables("Tenants-1")
+	tenantVariables.LibraryVariables = map[string]variables.LibraryVariable{
+		"LibraryVariableSets-1": {
+			Templates: []*actiontemplates.ActionTemplateParameter{
+				newTestTemplate("common-required", nil, false),
+				newTestTemplate("common-default", &withDefault, false),
+			},
+			Variables: map[string]core.PropertyValue{"common-default": core.NewPropertyValue("old", false)},
+		},
+	}
+	tenantVariables.ProjectVariables = map[string]variables.ProjectVariable{
+		"Projects-1": {
+			Templates: []*actiontemplates.ActionTemplateParameter{
+				newTestTemplate("project-secret", nil, true),
+			},
+			Variables: map[string]map[string]core.PropertyValue{
+				"Environments-1": {},
+				"Environments-2": {},
+			},
+		},
+	}
+	return tenantVariables
+}
+
+func TestValidateTenantVariableValues(t *testing.T) {
+	values := tenantVariableValues{
+		common: map[tenantVariableKey]types.String{
+			{ownerID: "LibraryVariableSets-1", templateID: "common-required"}: types.StringValue("value"),
+		},
+		project: map[tenantVariableKey]types.String{
+			{ownerID: "Projects-1", environmentID: "Environments-1", templateID: "project-secret"}: types.StringValue("secret"),
+			{ownerID: "Projects-1", environmentID: "Environments-2", templateID: "project-secret"}: types.StringUnknown(),
+		},
+	}
+	assert.False(t, validateTenantVariableValues(newTestTenantVariables(), values).HasError())
+
+	delete(values.common, tenantVariableKey{ownerID: "LibraryVariableSets-1", templateID: "common-required"})
+	values.project[tenantVariableKey{ownerID: "Projects-1", environmentID: "Environments-1", templateID: "project-secret"}] = types.StringValue("")
+	diags := validateTenantVariableValues(newTestTenantVariables(), values)
+	require.Equal(t, 2, diags.ErrorsCount())
+	assert.Contains(t, diags.Errors()[0].Detail(), "'common-required' (common-required) of library variable set 'LibraryVariableSets-1' has no default value")
+	assert.Contains(t, diags.Errors()[1].Detail(), "needs a value in environment 'Environments-1'")
+
+	unknown := tenantVariableValues{
+		common: map[tenantVariableKey]types.String{
+			{ownerID: "LibraryVariableSets-1", templateID: "common-required"}: types.StringValue("value"),
+			{ownerID: "LibraryVariableSets-2", templateID: "other"}:           types.StringValue("value"),
+		},
+		project: map[tenantVariableKey]types.String{
+			{ownerID: "Projects-1", environmentID: "Environments-1", templateID: "project-secret"}: types.StringValue("secret"),
+			{ownerID: "Projects-1", environmentID: "Environments-2", templateID: "project-secret"}: types.StringValue("secret"),
+			{ownerID: "Projects-1", environmentID: "Environments-3", templateID: "project-secret"}: types.StringValue("secret"),
+		},
+	}
+	diags = validateTenantVariableValues(newTestTenantVariables(), unknown)
+	require.Equal(t, 2, diags.ErrorsCount())
+	assert.Contains(t, diags.Errors()[0].Detail(), "'LibraryVariableSets-2' is not included")
+	assert.Contains(t, diags.Errors()[1].Detail(), "in environment 'Environments-3'")
+}
+
+func TestReplaceTenantVariableValues(t *testing.T) {
+	tenantVariables := newTestTenantVariables()
+	replaceTenantVariableValues(tenantVariables, tenantVariableValues{
+		common: map[tenantVariableKey]types.String{
+			{ownerID: "LibraryVariableSets-1", templateID: "common-required"}: types.StringValue("value"),
+		},
+		project: map[tenantVariableKey]types.String{
+			{ownerID: "Projects-1", environmentID: "Environments-2", templateID: "project-secret"}: types.StringValue("secret"),
+		},
+	})
+
+	assert.Equal(t, map[string]core.PropertyValue{
+		"common-required": core.NewPropertyValue("value", false),
+	}, tenantVariables.LibraryVariables["LibraryVariableSets-1"].Variables, "values that are not planned are removed")
+	assert.Equal(t, map[string]map[string]core.PropertyValue{
+		"Environments-1": {},
+		"Environments-2": {"project-secret": core.NewPropertyValue("secret", true)},
+	}, tenantVariables.ProjectVariables["Projects-1"].Variables)
+}
+
+func TestFlattenTenantVariableValues(t *testing.T) {
+	tenantVariables := newTestTenantVariables()
+	tenantVariables.ProjectVariables["Projects-1"].Variables["Environments-1"]["project-secret"] = core.PropertyValue{IsSensitive: true, SensitiveValue: &core.SensitiveValue{HasValue: true}}
+	tenantVariables.ProjectVariables["Projects-1"].Variables["Environments-2"]["project-secret"] = core.PropertyValue{IsSensitive: true, SensitiveValue: &core.SensitiveValue{HasValue: true}}
+
+	prior := tenantVariableValues{
+		project: map[tenantVariableKey]types.String{
+			{ownerID: "Projects-1", environmentID: "Environments-1", templateID: "project-secret"}: types.StringValue("secret"),
+		},
+	}
+	current := flattenTenantVariableValues(tenantVariables, prior)
+
+	assert.Equal(t, map[tenantVariableKey]types.String{
+		{ownerID: "LibraryVariableSets-1", templateID: "common-default"}: types.StringValue("old"),
+	}, current.common)
+	assert.Equal(t, map[tenantVariableKey]types.String{
+		{ownerID: "Projects-1", environmentID: "Environments-1", templateID: "project-secret"}: types.StringValue("secret"),
+		{ownerID: "Projects-1", environmentID: "Environments-2", templateID: "project-secret"}: types.StringValue(""),
+	}, current.project, "sensitive values keep the prior value")
+}
@@ -36,8 +36,8 @@ func (t *tentacleCertificateResource) Schema(ctx context.Context, req resource.S
 	resp.Schema = schemas.GetTentacleCertificateSchema()
 }
 
-func (t *tentacleCertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	t.Config = ResourceConfiguration(req, resp)
+func (t *tentacleCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	t.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (t *tentacleCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
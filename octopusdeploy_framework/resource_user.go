@@ -29,8 +29,8 @@ func (r *userTypeResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schemas.UserSchema{}.GetResourceSchema()
 }
 
-func (r *userTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *userTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *userTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
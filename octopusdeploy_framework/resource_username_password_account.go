@@ -33,8 +33,8 @@ func (r *usernamePasswordAccountResource) Schema(_ context.Context, _ resource.S
 	resp.Schema = schemas.UsernamePasswordAccountSchema{}.GetResourceSchema()
 }
 
-func (r *usernamePasswordAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+func (r *usernamePasswordAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 func (r *usernamePasswordAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan schemas.UsernamePasswordAccountResourceModel
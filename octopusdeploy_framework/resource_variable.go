@@ -22,7 +22,11 @@ type variableTypeResource struct {
 	*Config
 }
 
-var _ resource.ResourceWithImportState = &variableTypeResource{}
+var (
+	_ resource.ResourceWithImportState    = &variableTypeResource{}
+	_ resource.ResourceWithValidateConfig = &variableTypeResource{}
+	_ resource.ResourceWithModifyPlan     = &variableTypeResource{}
+)
 
 func NewVariableResource() resource.Resource {
 	return &variableTypeResource{}
@@ -52,7 +56,7 @@ func (r *variableTypeResource) Schema(ctx context.Context, req resource.SchemaRe
 }
 
 func (r *variableTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.Config = ResourceConfiguration(req, resp)
+	r.Config = ResourceConfiguration(ctx, req, resp)
 }
 
 func (r *variableTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -77,10 +81,16 @@ func (r *variableTypeResource) Create(ctx context.Context, req resource.CreateRe
 	newVariable.IsEditable = data.IsEditable.ValueBool()
 	newVariable.IsSensitive = data.IsSensitive.ValueBool()
 	newVariable.Type = data.Type.ValueString()
-	newVariable.Scope = schemas.MapToVariableScope(data.Scope)
 	newVariable.Prompt = schemas.MapToVariablePromptOptions(data.Prompt)
 	newVariable.SpaceID = data.SpaceID.ValueString()
 
+	resolver := newVariableScopeResolver(r.Config, data.SpaceID.ValueString(), variableOwnerId.ValueString())
+	newVariable.Scope, err = resolveVariableScope(resolver, schemas.MapToVariableScope(data.Scope))
+	if err != nil {
+		resp.Diagnostics.AddError("create variable failed", err.Error())
+		return
+	}
+
 	if newVariable.IsSensitive {
 		newVariable.Type = schemas.VariableTypeNames.Sensitive
 		newVariable.Value = data.SensitiveValue.ValueString()
@@ -102,7 +112,9 @@ func (r *variableTypeResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	scope := data.Scope
 	mapVariableToState(&data, newVariable)
+	data.Scope = configuredVariableScope(resolver, scope, newVariable.Scope)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -141,7 +153,9 @@ func (r *variableTypeResource) Read(ctx context.Context, req resource.ReadReques
 	variable.SpaceID = data.SpaceID.ValueString()
 
 	tflog.Info(ctx, fmt.Sprintf("Read variable: %+v", variable))
+	scope := data.Scope
 	mapVariableToState(&data, variable)
+	data.Scope = configuredVariableScope(newVariableScopeResolver(r.Config, data.SpaceID.ValueString(), variableOwnerID.ValueString()), scope, variable.Scope)
 
 	tflog.Info(ctx, fmt.Sprintf("SpaceID after mapping: %s", data.SpaceID.ValueString()))
 
@@ -173,10 +187,16 @@ func (r *variableTypeResource) Update(ctx context.Context, req resource.UpdateRe
 	updatedVariable.IsEditable = plan.IsEditable.ValueBool()
 	updatedVariable.IsSensitive = plan.IsSensitive.ValueBool()
 	updatedVariable.Type = plan.Type.ValueString()
-	updatedVariable.Scope = schemas.MapToVariableScope(plan.Scope)
 	updatedVariable.Prompt = schemas.MapToVariablePromptOptions(plan.Prompt)
 	updatedVariable.SpaceID = plan.SpaceID.ValueString()
 
+	resolver := newVariableScopeResolver(r.Config, plan.SpaceID.ValueString(), variableOwnerId.ValueString())
+	updatedVariable.Scope, err = resolveVariableScope(resolver, schemas.MapToVariableScope(plan.Scope))
+	if err != nil {
+		resp.Diagnostics.AddError("update variable failed", err.Error())
+		return
+	}
+
 	if updatedVariable.IsSensitive {
 		updatedVariable.Type = schemas.VariableTypeNames.Sensitive
 		updatedVariable.Value = plan.SensitiveValue.ValueString()
@@ -200,7 +220,9 @@ func (r *variableTypeResource) Update(ctx context.Context, req resource.UpdateRe
 
 	tflog.Info(ctx, fmt.Sprintf("variable updated (%s)", plan.ID))
 
+	scope := plan.Scope
 	mapVariableToState(&plan, updatedVariable)
+	plan.Scope = configuredVariableScope(resolver, scope, updatedVariable.Scope)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -251,6 +273,35 @@ func (r *variableTypeResource) ValidateConfig(ctx context.Context, req resource.
 			fmt.Sprintf("when type is set to '%s', %s needs to be true", schemas.VariableSchemaAttributeNames.IsSensitive, schemas.VariableTypeNames.Sensitive),
 		)
 	}
+
+	if data.ProjectID.IsNull() {
+		resp.Diagnostics.Append(validateVariableScopeOwner(data.OwnerID, data.Scope)...)
+	}
+}
+
+// ModifyPlan resolves the scope values of the variable against the entities of its owner, so a stale ID or a
+// misspelled name is reported by the plan instead of creating a variable that never applies.
+func (r *variableTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.Config.Offline {
+		return // When deleting, the scope is validated when the provider configuration is known
+	}
+
+	var plan schemas.VariableTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ownerID := plan.ProjectID
+	if ownerID.IsNull() {
+		ownerID = plan.OwnerID
+	}
+	if ownerID.IsNull() || ownerID.IsUnknown() {
+		return // The scope values of an owner created in the same apply are resolved when the variable is created
+	}
+
+	resolver := newVariableScopeResolver(r.Config, plan.SpaceID.ValueString(), ownerID.ValueString())
+	resp.Diagnostics.Append(validateVariableScope(resolver, plan.Scope)...)
 }
 
 func getVariableOwnerID(data *schemas.VariableTypeResourceModel) (*basetypes.StringValue, error) {
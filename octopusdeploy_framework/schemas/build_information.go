@@ -0,0 +1,168 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/buildinformation"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const BuildInformationResourceName = "build_information"
+
+var BuildInformationOverwriteModes = []string{
+	string(buildinformation.OverwriteModeFailIfExists),
+	string(buildinformation.OverwriteModeOverwriteExisting),
+	string(buildinformation.OverwriteModeIgnoreIfExists),
+}
+
+type BuildInformationResourceModel struct {
+	SpaceID          types.String `tfsdk:"space_id"`
+	PackageID        types.String `tfsdk:"package_id"`
+	Version          types.String `tfsdk:"version"`
+	BuildEnvironment types.String `tfsdk:"build_environment"`
+	BuildNumber      types.String `tfsdk:"build_number"`
+	BuildURL         types.String `tfsdk:"build_url"`
+	Branch           types.String `tfsdk:"branch"`
+	VcsType          types.String `tfsdk:"vcs_type"`
+	VcsRoot          types.String `tfsdk:"vcs_root"`
+	VcsCommitNumber  types.String `tfsdk:"vcs_commit_number"`
+	Commits          types.List   `tfsdk:"commits"`
+	OverwriteMode    types.String `tfsdk:"overwrite_mode"`
+	VcsCommitURL     types.String `tfsdk:"vcs_commit_url"`
+	IssueTrackerName types.String `tfsdk:"issue_tracker_name"`
+	WorkItems        types.List   `tfsdk:"work_items"`
+
+	ResourceModel
+}
+
+type BuildInformationCommitModel struct {
+	ID      types.String `tfsdk:"id"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+type BuildInformationWorkItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	LinkURL     types.String `tfsdk:"link_url"`
+	Source      types.String `tfsdk:"source"`
+}
+
+type BuildInformationSchema struct{}
+
+var _ EntitySchema = BuildInformationSchema{}
+
+func (b BuildInformationSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{
+		Description: "Manages the build information of a package version in Octopus Deploy, which links releases to the commits and work items of the build that produced the package.",
+		Attributes: map[string]resourceSchema.Attribute{
+			"id": GetIdResourceSchema(),
+			"space_id": util.ResourceString().
+				Optional().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()).
+				Description("The space ID associated with this build information.").
+				Build(),
+			"package_id": util.ResourceString().
+				Required().
+				PlanModifiers(stringplanmodifier.RequiresReplace()).
+				Validators(stringvalidator.LengthAtLeast(1)).
+				Description("The ID of the package the build information is for.").
+				Build(),
+			"version": util.ResourceString().
+				Required().
+				PlanModifiers(stringplanmodifier.RequiresReplace()).
+				Validators(stringvalidator.LengthAtLeast(1)).
+				Description("The version of the package the build information is for.").
+				Build(),
+			"build_environment": util.ResourceString().
+				Optional().
+				Description("The build server that built the package, e.g. `GitHub Actions`, `Azure DevOps` or `TeamCity`.").
+				Build(),
+			"build_number": util.ResourceString().
+				Optional().
+				Description("The number of the build that built the package.").
+				Build(),
+			"build_url": util.ResourceString().
+				Optional().
+				Description("The URL of the build that built the package.").
+				Build(),
+			"branch": util.ResourceString().
+				Optional().
+				Description("The branch the package was built from.").
+				Build(),
+			"vcs_type": util.ResourceString().
+				Optional().
+				Description("The type of version control system the package was built from, e.g. `Git`.").
+				Build(),
+			"vcs_root": util.ResourceString().
+				Optional().
+				Description("The URL of the repository the package was built from.").
+				Build(),
+			"vcs_commit_number": util.ResourceString().
+				Optional().
+				Description("The commit the package was built from.").
+				Build(),
+			"commits": resourceSchema.ListNestedAttribute{
+				Description: "The commits in the build, which Octopus Deploy reads work item references from.",
+				Optional:    true,
+				NestedObject: resourceSchema.NestedAttributeObject{
+					Attributes: map[string]resourceSchema.Attribute{
+						"id":      util.ResourceString().Required().Validators(stringvalidator.LengthAtLeast(1)).Description("The ID of the commit.").Build(),
+						"comment": util.ResourceString().Optional().Description("The commit message.").Build(),
+					},
+				},
+			},
+			"overwrite_mode": util.ResourceString().
+				Optional().
+				Computed().
+				Default(string(buildinformation.OverwriteModeFailIfExists)).
+				Validators(stringvalidator.OneOf(BuildInformationOverwriteModes...)).
+				Description("What to do when the package version already has build information that this resource doesn't manage: `FailIfExists`, `OverwriteExisting` or `IgnoreIfExists`. Defaults to `FailIfExists`. A change to build information that this resource created or imported always overwrites it. When the server keeps different build information because the mode is `IgnoreIfExists`, the next plan shows the difference, a change is pushed with this mode again and the resource doesn't delete the build information.").
+				Build(),
+			"vcs_commit_url": util.ResourceString().
+				Computed().
+				Description("The URL of the commit the package was built from.").
+				Build(),
+			"issue_tracker_name": util.ResourceString().
+				Computed().
+				Description("The name of the issue tracker that the work items are from.").
+				Build(),
+			"work_items": resourceSchema.ListNestedAttribute{
+				Description: "The work items that Octopus Deploy found in the commits.",
+				Computed:    true,
+				NestedObject: resourceSchema.NestedAttributeObject{
+					Attributes: map[string]resourceSchema.Attribute{
+						"id":          util.ResourceString().Computed().Description("The ID of the work item.").Build(),
+						"description": util.ResourceString().Computed().Description("The description of the work item.").Build(),
+						"link_url":    util.ResourceString().Computed().Description("The URL of the work item.").Build(),
+						"source":      util.ResourceString().Computed().Description("The issue tracker of the work item.").Build(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (b BuildInformationSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{}
+}
+
+func BuildInformationCommitObjectType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":      types.StringType,
+		"comment": types.StringType,
+	}
+}
+
+func BuildInformationWorkItemObjectType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"description": types.StringType,
+		"link_url":    types.StringType,
+		"source":      types.StringType,
+	}
+}
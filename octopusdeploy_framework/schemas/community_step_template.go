@@ -1,6 +1,8 @@
 package schemas
 
 import (
+	"context"
+
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	ds "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -20,10 +22,11 @@ const (
 
 // CommunityStepTemplateTypeDataSourceModel represents the data source defined in the Terraform configuration.
 type CommunityStepTemplateTypeDataSourceModel struct {
-	ID      types.String `tfsdk:"id"`
-	Website types.String `tfsdk:"website"`
-	Name    types.String `tfsdk:"name"`
-	Steps   types.List   `tfsdk:"steps"` // Steps used the type CommunityStepTemplateTypeObjectType()
+	ID              types.String `tfsdk:"id"`
+	Website         types.String `tfsdk:"website"`
+	Name            types.String `tfsdk:"name"`
+	CatalogueSource types.String `tfsdk:"catalogue_source"`
+	Steps           types.List   `tfsdk:"steps"` // Steps used the type CommunityStepTemplateTypeObjectType()
 }
 
 // CommunityStepTemplateTypeObjectType returns the type mapping used to define the Steps attribute in the CommunityStepTemplateTypeDataSourceModel.
@@ -108,10 +111,17 @@ type StepTemplateFromCommunityStepTemplateTypeResourceModel struct {
 	Parameters                types.List   `tfsdk:"parameters"`
 	Properties                types.Map    `tfsdk:"properties"`
 	Version                   types.Int32  `tfsdk:"version"`
+	CatalogueSource           types.String `tfsdk:"catalogue_source"`
+	CatalogueVersion          types.Int32  `tfsdk:"catalogue_version"`
 
 	ResourceModel
 }
 
+// CommunityStepTemplateCatalogueSourceDescription describes the sources a community step template catalogue is read from.
+const CommunityStepTemplateCatalogueSourceDescription = "A catalogue of community step templates to use instead of the community library synced by the Octopus Server, for instances without access to the internet. " +
+	"It is the path of a JSON export file, the path of a directory of JSON files such as the `step-templates` directory of the community library repository, or an `http` or `https` URL of an internal mirror serving a JSON export. " +
+	"A JSON document contains a single template, an array of templates or an object with an `Items` array of templates."
+
 type CommunityStepTemplateSchema struct{}
 
 var _ EntitySchema = CommunityStepTemplateSchema{}
@@ -132,6 +142,10 @@ func (s CommunityStepTemplateSchema) GetDatasourceSchema() ds.Schema {
 				Description("Website of the Community Step Template").
 				Optional().
 				Build(),
+			"catalogue_source": util.ResourceString().
+				Description(CommunityStepTemplateCatalogueSourceDescription).
+				Optional().
+				Build(),
 			"steps": ds.ListNestedAttribute{
 				Computed: true,
 				NestedObject: ds.NestedAttributeObject{
@@ -203,10 +217,20 @@ func (s CommunityStepTemplateSchema) GetResourceSchema() rs.Schema {
 				PlanModifiers(stringplanmodifier.UseStateForUnknown()).
 				Build(),
 			"community_action_template_id": util.ResourceString().
-				Description("The ID of the community action template").
+				Description("The ID of the community action template. With `catalogue_source`, it is the ID of the template in the catalogue, or the ID of the template in the community library.").
 				Required().
 				PlanModifiers(stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()).
 				Build(),
+			"catalogue_source": util.ResourceString().
+				Description(CommunityStepTemplateCatalogueSourceDescription + " The template is installed as a regular step template that records the `community_action_template_id` in its `Octopus.Terraform.CommunityActionTemplateId` property, as the Octopus Server only keeps the ID of templates in the community library it synced, and is upgraded when the version of the template in the catalogue changes.").
+				Optional().
+				PlanModifiers(stringplanmodifier.RequiresReplaceIf(requiresReplaceWhenCatalogueSourceIsAddedOrRemoved, "Changing between the community library synced by the Octopus Server and a catalogue requires the step template to be installed again.", "Changing between the community library synced by the Octopus Server and a catalogue requires the step template to be installed again.")).
+				Build(),
+			"catalogue_version": util.ResourceInt32().
+				Description("The version of the template in the catalogue that is installed. Only set with `catalogue_source`.").
+				Computed().
+				PlanModifiers(int32planmodifier.UseStateForUnknown()).
+				Build(),
 			"packages":   GetReadOnlyStepTemplatePackageResourceSchema(),
 			"parameters": GetReadOnlyStepTemplateParameters(),
 			"properties": util.ResourceMap(types.StringType).
@@ -327,3 +351,7 @@ func GetReadOnlyStepTemplatePackageResourceSchema() rs.ListNestedAttribute {
 		},
 	}
 }
+
+func requiresReplaceWhenCatalogueSourceIsAddedOrRemoved(_ context.Context, request planmodifier.StringRequest, response *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	response.RequiresReplace = request.StateValue.IsNull() != request.PlanValue.IsNull()
+}
@@ -0,0 +1,120 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const DeploymentsDataSourceName = "deployments"
+
+type DeploymentsDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	SpaceID        types.String `tfsdk:"space_id"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	EnvironmentIDs types.List   `tfsdk:"environment_ids"`
+	TenantIDs      types.List   `tfsdk:"tenant_ids"`
+	Deployments    types.List   `tfsdk:"deployments"`
+}
+
+type DeploymentModel struct {
+	EnvironmentID           types.String `tfsdk:"environment_id"`
+	TenantID                types.String `tfsdk:"tenant_id"`
+	ChannelID               types.String `tfsdk:"channel_id"`
+	ReleaseID               types.String `tfsdk:"release_id"`
+	ReleaseVersion          types.String `tfsdk:"release_version"`
+	DeploymentID            types.String `tfsdk:"deployment_id"`
+	TaskID                  types.String `tfsdk:"task_id"`
+	State                   types.String `tfsdk:"state"`
+	ErrorMessage            types.String `tfsdk:"error_message"`
+	IsCompleted             types.Bool   `tfsdk:"is_completed"`
+	IsCurrent               types.Bool   `tfsdk:"is_current"`
+	IsPrevious              types.Bool   `tfsdk:"is_previous"`
+	HasPendingInterruptions types.Bool   `tfsdk:"has_pending_interruptions"`
+	HasWarningsOrErrors     types.Bool   `tfsdk:"has_warnings_or_errors"`
+	Created                 types.String `tfsdk:"created"`
+	QueueTime               types.String `tfsdk:"queue_time"`
+	StartTime               types.String `tfsdk:"start_time"`
+	CompletedTime           types.String `tfsdk:"completed_time"`
+}
+
+type DeploymentsSchema struct{}
+
+var _ EntitySchema = DeploymentsSchema{}
+
+func (s DeploymentsSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{}
+}
+
+func (s DeploymentsSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Description: "Provides information about the deployments of a project to each environment and tenant, as shown on the Octopus Deploy dashboard.",
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id":       GetIdDatasourceSchema(true),
+			"space_id": GetSpaceIdDatasourceSchema("deployments", false),
+			"project_id": util.DataSourceString().
+				Required().
+				Description("The ID of the project of the deployments.").
+				Build(),
+			"environment_ids": util.DataSourceList(types.StringType).
+				Optional().
+				Description("Only returns the deployments to these environments.").
+				Build(),
+			"tenant_ids": util.DataSourceList(types.StringType).
+				Optional().
+				Description("Only returns the deployments for these tenants.").
+				Build(),
+			"deployments": datasourceSchema.ListNestedAttribute{
+				Description: "The deployments on the dashboard: the current deployment to each environment and tenant and the deployment before it, along with any deployment that is queued or running.",
+				Computed:    true,
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: map[string]datasourceSchema.Attribute{
+						"environment_id":            util.DataSourceString().Computed().Description("The ID of the environment of the deployment.").Build(),
+						"tenant_id":                 util.DataSourceString().Computed().Description("The ID of the tenant of the deployment, or null for an untenanted deployment.").Build(),
+						"channel_id":                util.DataSourceString().Computed().Description("The ID of the channel of the deployed release.").Build(),
+						"release_id":                util.DataSourceString().Computed().Description("The ID of the deployed release.").Build(),
+						"release_version":           util.DataSourceString().Computed().Description("The version of the deployed release.").Build(),
+						"deployment_id":             util.DataSourceString().Computed().Description("The ID of the deployment.").Build(),
+						"task_id":                   util.DataSourceString().Computed().Description("The ID of the server task of the deployment.").Build(),
+						"state":                     util.DataSourceString().Computed().Description("The state of the server task of the deployment: `Queued`, `Executing`, `Cancelling`, `Success`, `Failed`, `Canceled` or `TimedOut`.").Build(),
+						"error_message":             util.DataSourceString().Computed().Description("The error message of a failed deployment.").Build(),
+						"is_completed":              util.DataSourceBool().Computed().Description("Whether the deployment has finished.").Build(),
+						"is_current":                util.DataSourceBool().Computed().Description("Whether the deployment is the current deployment to the environment and tenant.").Build(),
+						"is_previous":               util.DataSourceBool().Computed().Description("Whether the deployment is the deployment before the current deployment to the environment and tenant.").Build(),
+						"has_pending_interruptions": util.DataSourceBool().Computed().Description("Whether the deployment is waiting on a manual intervention or guided failure.").Build(),
+						"has_warnings_or_errors":    util.DataSourceBool().Computed().Description("Whether the deployment logged warnings or errors.").Build(),
+						"created":                   util.DataSourceString().Computed().Description("The time the deployment was created, in RFC 3339 format.").Build(),
+						"queue_time":                util.DataSourceString().Computed().Description("The time the deployment was queued to start, in RFC 3339 format.").Build(),
+						"start_time":                util.DataSourceString().Computed().Description("The time the deployment started, in RFC 3339 format.").Build(),
+						"completed_time":            util.DataSourceString().Computed().Description("The time the deployment finished, in RFC 3339 format.").Build(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func DeploymentObjectType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"environment_id":            types.StringType,
+		"tenant_id":                 types.StringType,
+		"channel_id":                types.StringType,
+		"release_id":                types.StringType,
+		"release_version":           types.StringType,
+		"deployment_id":             types.StringType,
+		"task_id":                   types.StringType,
+		"state":                     types.StringType,
+		"error_message":             types.StringType,
+		"is_completed":              types.BoolType,
+		"is_current":                types.BoolType,
+		"is_previous":               types.BoolType,
+		"has_pending_interruptions": types.BoolType,
+		"has_warnings_or_errors":    types.BoolType,
+		"created":                   types.StringType,
+		"queue_time":                types.StringType,
+		"start_time":                types.StringType,
+		"completed_time":            types.StringType,
+	}
+}
@@ -0,0 +1,68 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const DeprecationsDataSourceName = "deprecations"
+
+type DeprecationsDataSourceModel struct {
+	ID           types.String                 `tfsdk:"id"`
+	ResourceType types.String                 `tfsdk:"resource_type"`
+	Deprecations []DeprecationDatasourceModel `tfsdk:"deprecations"`
+}
+
+type DeprecationDatasourceModel struct {
+	ResourceType   types.String `tfsdk:"resource_type"`
+	Attribute      types.String `tfsdk:"attribute"`
+	Message        types.String `tfsdk:"message"`
+	Replacement    types.String `tfsdk:"replacement"`
+	RemovalVersion types.String `tfsdk:"removal_version"`
+	ReversalKey    types.String `tfsdk:"reversal_key"`
+	Disabled       types.Bool   `tfsdk:"disabled"`
+}
+
+type DeprecationsSchema struct{}
+
+var _ EntitySchema = DeprecationsSchema{}
+
+func (d DeprecationsSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{}
+}
+
+func (d DeprecationsSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Description: "Provides a report of the deprecated resources, attributes and blocks of this provider, to plan upgrades of configurations before deprecated features are removed. Reads no data from the Octopus Server.",
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id": util.DataSourceString().Computed().Description("An auto-generated identifier that includes the timestamp when this data source was last modified.").Build(),
+			"resource_type": util.DataSourceString().
+				Optional().
+				Description("A resource type to filter by, e.g. `octopusdeploy_project`.").
+				Build(),
+			"deprecations": datasourceSchema.ListNestedAttribute{
+				Description: "A list of deprecations, ordered by resource type and attribute.",
+				Computed:    true,
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: map[string]datasourceSchema.Attribute{
+						"resource_type": util.DataSourceString().Computed().Description("The type of the deprecated resource, or of the resource with the deprecated attribute.").Build(),
+						"attribute":     util.DataSourceString().Computed().Description("The deprecated attribute or block, e.g. `process[*].name`. Empty when the whole resource is deprecated.").Build(),
+						"message":       util.DataSourceString().Computed().Description("The deprecation message shown when the resource or attribute is used.").Build(),
+						"replacement":   util.DataSourceString().Computed().Description("The attribute to use instead, if known.").Build(),
+						"removal_version": util.DataSourceString().
+							Computed().
+							Description("The provider version the attribute will be removed in, if known.").
+							Build(),
+						"reversal_key": util.DataSourceString().
+							Computed().
+							Description("The key to list in the `TF_OCTOPUS_DEPRECATION_REVERSALS` environment variable to re-enable a disabled attribute.").
+							Build(),
+						"disabled": util.DataSourceBool().Computed().Description("Whether setting the attribute is an error with the current `TF_OCTOPUS_DEPRECATION_REVERSALS`.").Build(),
+					},
+				},
+			},
+		},
+	}
+}
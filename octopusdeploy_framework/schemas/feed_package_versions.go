@@ -0,0 +1,106 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const FeedPackageVersionsDataSourceName = "feed_package_versions"
+
+type FeedPackageVersionsDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	SpaceID           types.String `tfsdk:"space_id"`
+	FeedID            types.String `tfsdk:"feed_id"`
+	PackageID         types.String `tfsdk:"package_id"`
+	VersionRange      types.String `tfsdk:"version_range"`
+	SemVerRange       types.String `tfsdk:"semver_range"`
+	IncludePrerelease types.Bool   `tfsdk:"include_prerelease"`
+	PrereleaseTag     types.String `tfsdk:"prerelease_tag"`
+	Latest            types.Bool   `tfsdk:"latest"`
+	Take              types.Int64  `tfsdk:"take"`
+	Versions          types.List   `tfsdk:"versions"`
+}
+
+type FeedPackageVersionModel struct {
+	Version   types.String `tfsdk:"version"`
+	Published types.String `tfsdk:"published"`
+	SizeBytes types.Int64  `tfsdk:"size_bytes"`
+}
+
+type FeedPackageVersionsSchema struct{}
+
+var _ EntitySchema = FeedPackageVersionsSchema{}
+
+func (f FeedPackageVersionsSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{}
+}
+
+func (f FeedPackageVersionsSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Description: "Provides the versions of a package in a feed, most recent first, as found by Octopus Deploy when it creates a release.",
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id":       GetIdDatasourceSchema(true),
+			"space_id": GetSpaceIdDatasourceSchema("feed", false),
+			"feed_id": datasourceSchema.StringAttribute{
+				Description: "The ID of the feed to search, e.g. the ID of an `octopusdeploy_helm_feed`. The built-in package repository has an ID like `Feeds-1001`.",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"package_id": datasourceSchema.StringAttribute{
+				Description: "The ID of the package, e.g. the name of a Helm chart or a container image.",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"version_range": util.DataSourceString().
+				Optional().
+				Description("Only returns the versions in a version range, which the server checks the same way as a channel version rule: in the Maven notation for Maven feeds, e.g. `[2.0,3.0)`, and in the NuGet notation for other feeds, e.g. `[2.0,3.0)` or `2.0` for 2.0 and later.").
+				Build(),
+			"semver_range": util.DataSourceString().
+				Optional().
+				Description("Only returns the versions in a SemVer range in the syntax of npm and Helm, e.g. `2.x`, `^2.1` or `>=1.2 <2.0`. A pre-release version is only in the range when `include_prerelease` or `prerelease_tag` is set, or when the range has a pre-release version, e.g. `>=2.0.0-0 <3.0.0`.").
+				Build(),
+			"include_prerelease": util.DataSourceBool().
+				Optional().
+				Description("Whether to return pre-release versions. Defaults to `false`.").
+				Build(),
+			"prerelease_tag": util.DataSourceString().
+				Optional().
+				Description("Only returns the versions whose pre-release tag matches a regular expression, as in a channel version rule, e.g. `^(|rc.*)$` for releases and release candidates. Setting it includes pre-release versions.").
+				Build(),
+			"latest": util.DataSourceBool().
+				Optional().
+				Description("Only returns the most recent version that matches the other filters.").
+				Build(),
+			"take": datasourceSchema.Int64Attribute{
+				Description: "The maximum number of versions to return. Defaults to every matching version.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"versions": datasourceSchema.ListNestedAttribute{
+				Description: "The matching versions of the package, most recent first.",
+				Computed:    true,
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: map[string]datasourceSchema.Attribute{
+						"version":    util.DataSourceString().Computed().Description("The version of the package.").Build(),
+						"published":  util.DataSourceString().Computed().Description("The time the version was published, in RFC 3339 format, when the feed reports it.").Build(),
+						"size_bytes": util.DataSourceInt64().Computed().Description("The size of the package, in bytes, when the feed reports it.").Build(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func FeedPackageVersionObjectType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"version":    types.StringType,
+		"published":  types.StringType,
+		"size_bytes": types.Int64Type,
+	}
+}
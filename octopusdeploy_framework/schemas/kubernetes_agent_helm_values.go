@@ -0,0 +1,176 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const KubernetesAgentHelmValuesDataSourceName = "kubernetes_agent_helm_values"
+
+type KubernetesAgentHelmValuesModel struct {
+	ID                              types.String `tfsdk:"id"`
+	Name                            types.String `tfsdk:"name"`
+	ServerURL                       types.String `tfsdk:"server_url"`
+	ServerCommsAddress              types.String `tfsdk:"server_comms_address"`
+	SpaceName                       types.String `tfsdk:"space_name"`
+	BearerToken                     types.String `tfsdk:"bearer_token"`
+	Certificate                     types.String `tfsdk:"certificate"`
+	SubscriptionURI                 types.String `tfsdk:"subscription_uri"`
+	MachinePolicyName               types.String `tfsdk:"machine_policy_name"`
+	Environments                    types.List   `tfsdk:"environments"`
+	TargetTags                      types.List   `tfsdk:"target_tags"`
+	Tenants                         types.List   `tfsdk:"tenants"`
+	TenantTags                      types.List   `tfsdk:"tenant_tags"`
+	TenantedDeploymentParticipation types.String `tfsdk:"tenanted_deployment_participation"`
+	DefaultNamespace                types.String `tfsdk:"default_namespace"`
+	WorkerPools                     types.List   `tfsdk:"worker_pools"`
+	StorageClassName                types.String `tfsdk:"storage_class_name"`
+	ChartVersion                    types.String `tfsdk:"chart_version"`
+	Namespace                       types.String `tfsdk:"namespace"`
+	ReleaseName                     types.String `tfsdk:"release_name"`
+	Chart                           types.String `tfsdk:"chart"`
+	Values                          types.String `tfsdk:"values"`
+	Set                             types.Map    `tfsdk:"set"`
+	SetSensitive                    types.Map    `tfsdk:"set_sensitive"`
+	InstallCommand                  types.String `tfsdk:"install_command"`
+}
+
+type KubernetesAgentHelmValuesSchema struct{}
+
+var _ EntitySchema = KubernetesAgentHelmValuesSchema{}
+
+func (k KubernetesAgentHelmValuesSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{}
+}
+
+func (k KubernetesAgentHelmValuesSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Description: "Renders the Helm chart values that install a Kubernetes agent, as a deployment target or a worker, for use with a `helm_release` or the Helm CLI.",
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id":   util.DataSourceString().Computed().Description("The name of the agent.").Build(),
+			"name": util.DataSourceString().Required().Description("The name of the agent, as shown in Octopus Deploy.").Build(),
+			"server_url": util.DataSourceString().
+				Optional().
+				Computed().
+				Description("The URL of the Octopus Server. Defaults to the address of the provider.").
+				Build(),
+			"server_comms_address": util.DataSourceString().
+				Optional().
+				Computed().
+				Description("The address the agent polls the Octopus Server on. Defaults to port 10943 of the server URL.").
+				Build(),
+			"space_name": util.DataSourceString().
+				Optional().
+				Computed().
+				Description("The name of the space to register the agent in. Defaults to the space of the provider.").
+				Build(),
+			"bearer_token": util.DataSourceString().
+				Optional().
+				Sensitive().
+				Description("A bearer token the agent registers itself with when it is installed. Either `bearer_token`, or `certificate` and `subscription_uri`, must be set.").
+				Build(),
+			"certificate": util.DataSourceString().
+				Optional().
+				Sensitive().
+				Description("The base64 encoded pfx certificate of an agent registered with the `octopusdeploy_kubernetes_agent_deployment_target` or `octopusdeploy_kubernetes_agent_worker` resources, e.g. from `octopusdeploy_tentacle_certificate`. Its thumbprint is the `thumbprint` of the registered agent.").
+				Build(),
+			"subscription_uri": util.DataSourceString().
+				Optional().
+				Description("The polling subscription URI of a registered agent, e.g. the `polling_uri` of `octopusdeploy_polling_subscription_id`. It is the `uri` of the registered agent.").
+				Build(),
+			"machine_policy_name": util.DataSourceString().
+				Optional().
+				Description("The name of the machine policy the agent registers itself with.").
+				Build(),
+			"environments": util.DataSourceList(types.StringType).
+				Optional().
+				Description("The names or slugs of the environments the agent registers itself in as a deployment target.").
+				Build(),
+			"target_tags": util.DataSourceList(types.StringType).
+				Optional().
+				Description("The target tags the agent registers itself with as a deployment target.").
+				Build(),
+			"tenants": util.DataSourceList(types.StringType).
+				Optional().
+				Description("The names or slugs of the tenants the agent registers itself with as a deployment target.").
+				Build(),
+			"tenant_tags": util.DataSourceList(types.StringType).
+				Optional().
+				Description("The canonical names of the tenant tags the agent registers itself with as a deployment target.").
+				Build(),
+			"tenanted_deployment_participation": util.DataSourceString().
+				Optional().
+				Description("The tenanted deployment mode the agent registers itself with as a deployment target. One of `Untenanted`, `TenantedOrUntenanted` or `Tenanted`.").
+				Build(),
+			"default_namespace": util.DataSourceString().
+				Optional().
+				Description("The default Kubernetes namespace of the deployment target.").
+				Build(),
+			"worker_pools": util.DataSourceList(types.StringType).
+				Optional().
+				Description("The names or slugs of the worker pools the agent registers itself in as a worker. Installs the agent as a worker instead of a deployment target.").
+				Build(),
+			"storage_class_name": util.DataSourceString().
+				Optional().
+				Description("The storage class of the agent's persistent volume. When not set, the agent runs its own NFS server for storage.").
+				Build(),
+			"chart_version": util.DataSourceString().
+				Optional().
+				Computed().
+				Description("The version of the Helm chart to install. Defaults to `" + KubernetesAgentDefaultChartVersion + "`.").
+				Build(),
+			"namespace": util.DataSourceString().
+				Optional().
+				Computed().
+				Description("The Kubernetes namespace to install the agent in. Defaults to `octopus-agent-` followed by the name of the agent.").
+				Build(),
+			"release_name": util.DataSourceString().
+				Optional().
+				Computed().
+				Description("The name of the Helm release. Defaults to the namespace.").
+				Build(),
+			"chart": util.DataSourceString().
+				Computed().
+				Description("The Helm chart of the agent.").
+				Build(),
+			"values": util.DataSourceString().
+				Computed().
+				Sensitive().
+				Description("The Helm chart values, including the bearer token or certificate. Rendered as JSON, which Helm reads as YAML.").
+				Build(),
+			"set": util.DataSourceMap(types.StringType).
+				Computed().
+				Description("The Helm chart values that are not sensitive, by the name used with `--set`.").
+				Build(),
+			"set_sensitive": util.DataSourceMap(types.StringType).
+				Computed().
+				Sensitive().
+				Description("The sensitive Helm chart values, by the name used with `--set`.").
+				Build(),
+			"install_command": util.DataSourceString().
+				Computed().
+				Sensitive().
+				Description("The Helm command that installs the agent.").
+				Build(),
+		},
+	}
+}
+
+func (k KubernetesAgentHelmValuesSchema) GetDatasourceConfigValidators() []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("bearer_token"), path.MatchRoot("certificate")),
+		datasourcevalidator.RequiredTogether(path.MatchRoot("certificate"), path.MatchRoot("subscription_uri")),
+		datasourcevalidator.Conflicting(path.MatchRoot("worker_pools"), path.MatchRoot("environments")),
+		datasourcevalidator.Conflicting(path.MatchRoot("worker_pools"), path.MatchRoot("target_tags")),
+	}
+}
+
+const (
+	KubernetesAgentChart               = "oci://registry-1.docker.io/octopusdeploy/kubernetes-agent"
+	KubernetesAgentDefaultChartVersion = "2.*.*"
+)
@@ -1,6 +1,7 @@
 package schemas
 
 import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -30,6 +31,13 @@ func (m ListeningTentacleWorkerSchema) GetResourceSchema() resourceSchema.Schema
 					setvalidator.SizeAtLeast(1),
 				},
 			},
+			"health_status": util.ResourceString().
+				Computed().
+				Description("The health status of the worker. One of `HasWarnings`, `Healthy`, `Unavailable`, `Unhealthy` or `Unknown`.").
+				Build(),
+		},
+		Blocks: map[string]resourceSchema.Block{
+			"wait_for_healthy": GetWaitForHealthyResourceBlock(),
 		},
 	}
 }
@@ -49,6 +57,8 @@ type ListeningTentacleWorkerResourceModel struct {
 	Uri             types.String `tfsdk:"uri"`
 	Thumbprint      types.String `tfsdk:"thumbprint"`
 	ProxyID         types.String `tfsdk:"proxy_id"`
+	HealthStatus    types.String `tfsdk:"health_status"`
+	WaitForHealthy  types.List   `tfsdk:"wait_for_healthy"`
 
 	ResourceModel
 }
@@ -0,0 +1,225 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const MachinesDataSourceName = "machines"
+
+const (
+	MachineKindDeploymentTarget = "DeploymentTarget"
+	MachineKindWorker           = "Worker"
+)
+
+var MachineHealthStatuses = []string{"HasWarnings", "Healthy", "Unavailable", "Unhealthy", "Unknown"}
+
+type MachinesDataSourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	SpaceID                types.String `tfsdk:"space_id"`
+	IDs                    types.List   `tfsdk:"ids"`
+	Name                   types.String `tfsdk:"name"`
+	PartialName            types.String `tfsdk:"partial_name"`
+	Kind                   types.String `tfsdk:"kind"`
+	Roles                  types.List   `tfsdk:"roles"`
+	EnvironmentIDs         types.List   `tfsdk:"environment_ids"`
+	TenantIDs              types.List   `tfsdk:"tenant_ids"`
+	TenantTags             types.List   `tfsdk:"tenant_tags"`
+	WorkerPoolIDs          types.List   `tfsdk:"worker_pool_ids"`
+	HealthStatuses         types.List   `tfsdk:"health_statuses"`
+	CommunicationStyles    types.List   `tfsdk:"communication_styles"`
+	IsDisabled             types.Bool   `tfsdk:"is_disabled"`
+	IncludeLastHealthCheck types.Bool   `tfsdk:"include_last_health_check"`
+	Skip                   types.Int64  `tfsdk:"skip"`
+	Take                   types.Int64  `tfsdk:"take"`
+	Machines               types.List   `tfsdk:"machines"`
+}
+
+type MachineModel struct {
+	ID                              types.String `tfsdk:"id"`
+	SpaceID                         types.String `tfsdk:"space_id"`
+	Kind                            types.String `tfsdk:"kind"`
+	Name                            types.String `tfsdk:"name"`
+	IsDisabled                      types.Bool   `tfsdk:"is_disabled"`
+	MachinePolicyID                 types.String `tfsdk:"machine_policy_id"`
+	CommunicationStyle              types.String `tfsdk:"communication_style"`
+	HealthStatus                    types.String `tfsdk:"health_status"`
+	StatusSummary                   types.String `tfsdk:"status_summary"`
+	HasLatestCalamari               types.Bool   `tfsdk:"has_latest_calamari"`
+	OperatingSystem                 types.String `tfsdk:"operating_system"`
+	ShellName                       types.String `tfsdk:"shell_name"`
+	Roles                           types.List   `tfsdk:"roles"`
+	EnvironmentIDs                  types.List   `tfsdk:"environment_ids"`
+	TenantIDs                       types.List   `tfsdk:"tenant_ids"`
+	TenantTags                      types.List   `tfsdk:"tenant_tags"`
+	TenantedDeploymentParticipation types.String `tfsdk:"tenanted_deployment_participation"`
+	WorkerPoolIDs                   types.List   `tfsdk:"worker_pool_ids"`
+	URI                             types.String `tfsdk:"uri"`
+	Thumbprint                      types.String `tfsdk:"thumbprint"`
+	ProxyID                         types.String `tfsdk:"proxy_id"`
+	Host                            types.String `tfsdk:"host"`
+	Port                            types.Int64  `tfsdk:"port"`
+	ClusterURL                      types.String `tfsdk:"cluster_url"`
+	TentacleVersion                 types.String `tfsdk:"tentacle_version"`
+	TentacleUpgradeSuggested        types.Bool   `tfsdk:"tentacle_upgrade_suggested"`
+	LastHealthCheck                 types.String `tfsdk:"last_health_check"`
+}
+
+type MachinesSchema struct{}
+
+var _ EntitySchema = MachinesSchema{}
+
+func (m MachinesSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{}
+}
+
+func (m MachinesSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Description: "Provides information about existing deployment targets and workers, with their endpoint, health and Tentacle version.",
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id":           GetIdDatasourceSchema(true),
+			"space_id":     GetSpaceIdDatasourceSchema("machines", false),
+			"ids":          GetQueryIDsDatasourceSchema(),
+			"name":         GetQueryNameDatasourceSchema(),
+			"partial_name": GetQueryPartialNameDatasourceSchema(),
+			"kind": datasourceSchema.StringAttribute{
+				Description: "A filter to search by the kind of machine, `" + MachineKindDeploymentTarget + "` or `" + MachineKindWorker + "`. Both are returned when not set. The filters that only apply to the other kind of machine can't be combined with it.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(MachineKindDeploymentTarget, MachineKindWorker),
+				},
+			},
+			"roles": util.DataSourceList(types.StringType).
+				Optional().
+				Description("A filter to search by target tags. Only deployment targets are returned.").
+				Build(),
+			"environment_ids": util.DataSourceList(types.StringType).
+				Optional().
+				Description("A filter to search by environment IDs. Only deployment targets are returned.").
+				Build(),
+			"tenant_ids": util.DataSourceList(types.StringType).
+				Optional().
+				Description("A filter to search by tenant IDs. Only deployment targets are returned.").
+				Build(),
+			"tenant_tags": util.DataSourceList(types.StringType).
+				Optional().
+				Description("A filter to search by the canonical names of tenant tags. Only deployment targets are returned.").
+				Build(),
+			"worker_pool_ids": util.DataSourceList(types.StringType).
+				Optional().
+				Description("A filter to search by worker pool IDs. Only workers are returned.").
+				Build(),
+			"health_statuses": datasourceSchema.ListAttribute{
+				Description: "A filter to search by health statuses. Valid values are `HasWarnings`, `Healthy`, `Unavailable`, `Unhealthy` and `Unknown`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(MachineHealthStatuses...)),
+				},
+			},
+			"communication_styles": datasourceSchema.ListAttribute{
+				Description: "A filter to search by communication styles, e.g. `TentaclePassive`, `TentacleActive`, `Ssh` or `KubernetesTentacle`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"is_disabled": util.DataSourceBool().
+				Optional().
+				Description("A filter to search for disabled, or enabled, machines.").
+				Build(),
+			"include_last_health_check": util.DataSourceBool().
+				Optional().
+				Description("Sets `last_health_check` on the machines. It reads the tasks of every machine returned, so it is slower with many machines.").
+				Build(),
+			"skip": GetQuerySkipDatasourceSchema(),
+			"take": GetQueryTakeDatasourceSchema(),
+			"machines": datasourceSchema.ListNestedAttribute{
+				Description: "The deployment targets and workers that match the filters, deployment targets first.",
+				Computed:    true,
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: getMachineDatasourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func getMachineDatasourceAttributes() map[string]datasourceSchema.Attribute {
+	return map[string]datasourceSchema.Attribute{
+		"id":                                GetIdDatasourceSchema(true),
+		"space_id":                          GetSpaceIdDatasourceSchema("machine", true),
+		"kind":                              util.DataSourceString().Computed().Description("The kind of machine, `" + MachineKindDeploymentTarget + "` or `" + MachineKindWorker + "`.").Build(),
+		"name":                              GetReadonlyNameDatasourceSchema(),
+		"is_disabled":                       util.DataSourceBool().Computed().Description("Whether the machine is disabled.").Build(),
+		"machine_policy_id":                 util.DataSourceString().Computed().Description("The ID of the machine policy of the machine.").Build(),
+		"communication_style":               util.DataSourceString().Computed().Description("The communication style of the machine's endpoint.").Build(),
+		"health_status":                     util.DataSourceString().Computed().Description("The health status of the machine.").Build(),
+		"status_summary":                    util.DataSourceString().Computed().Description("The summary of the last health check of the machine.").Build(),
+		"has_latest_calamari":               util.DataSourceBool().Computed().Description("Whether the machine has the latest version of Calamari.").Build(),
+		"operating_system":                  util.DataSourceString().Computed().Description("The operating system of the machine.").Build(),
+		"shell_name":                        util.DataSourceString().Computed().Description("The shell of the machine.").Build(),
+		"roles":                             util.DataSourceList(types.StringType).Computed().Description("The target tags of the deployment target.").Build(),
+		"environment_ids":                   util.DataSourceList(types.StringType).Computed().Description("The environments of the deployment target.").Build(),
+		"tenant_ids":                        util.DataSourceList(types.StringType).Computed().Description("The tenants of the deployment target.").Build(),
+		"tenant_tags":                       util.DataSourceList(types.StringType).Computed().Description("The tenant tags of the deployment target.").Build(),
+		"tenanted_deployment_participation": util.DataSourceString().Computed().Description("The tenanted deployment mode of the deployment target.").Build(),
+		"worker_pool_ids":                   util.DataSourceList(types.StringType).Computed().Description("The worker pools of the worker.").Build(),
+		"uri":                               util.DataSourceString().Computed().Description("The URI of a Tentacle, Kubernetes agent or SSH endpoint.").Build(),
+		"thumbprint":                        util.DataSourceString().Computed().Description("The certificate thumbprint of a Tentacle or Kubernetes agent endpoint.").Build(),
+		"proxy_id":                          util.DataSourceString().Computed().Description("The ID of the proxy of the endpoint.").Build(),
+		"host":                              util.DataSourceString().Computed().Description("The host of an SSH endpoint.").Build(),
+		"port":                              util.DataSourceInt64().Computed().Description("The port of an SSH endpoint.").Build(),
+		"cluster_url":                       util.DataSourceString().Computed().Description("The cluster URL of a Kubernetes cluster endpoint.").Build(),
+		"tentacle_version":                  util.DataSourceString().Computed().Description("The version of the Tentacle or Kubernetes agent.").Build(),
+		"tentacle_upgrade_suggested":        util.DataSourceBool().Computed().Description("Whether an upgrade of the Tentacle or Kubernetes agent is suggested or required.").Build(),
+		"last_health_check":                 util.DataSourceString().Computed().Description("The time the last health check of the machine completed, in RFC 3339 format. Only set when `include_last_health_check` is true.").Build(),
+	}
+}
+
+func MachineObjectType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                                types.StringType,
+		"space_id":                          types.StringType,
+		"kind":                              types.StringType,
+		"name":                              types.StringType,
+		"is_disabled":                       types.BoolType,
+		"machine_policy_id":                 types.StringType,
+		"communication_style":               types.StringType,
+		"health_status":                     types.StringType,
+		"status_summary":                    types.StringType,
+		"has_latest_calamari":               types.BoolType,
+		"operating_system":                  types.StringType,
+		"shell_name":                        types.StringType,
+		"roles":                             types.ListType{ElemType: types.StringType},
+		"environment_ids":                   types.ListType{ElemType: types.StringType},
+		"tenant_ids":                        types.ListType{ElemType: types.StringType},
+		"tenant_tags":                       types.ListType{ElemType: types.StringType},
+		"tenanted_deployment_participation": types.StringType,
+		"worker_pool_ids":                   types.ListType{ElemType: types.StringType},
+		"uri":                               types.StringType,
+		"thumbprint":                        types.StringType,
+		"proxy_id":                          types.StringType,
+		"host":                              types.StringType,
+		"port":                              types.Int64Type,
+		"cluster_url":                       types.StringType,
+		"tentacle_version":                  types.StringType,
+		"tentacle_upgrade_suggested":        types.BoolType,
+		"last_health_check":                 types.StringType,
+	}
+}
+
+func (m MachinesSchema) GetDatasourceConfigValidators() []datasource.ConfigValidator {
+	var validators []datasource.ConfigValidator
+	for _, targetFilter := range []string{"roles", "environment_ids", "tenant_ids", "tenant_tags"} {
+		validators = append(validators, datasourcevalidator.Conflicting(path.MatchRoot("worker_pool_ids"), path.MatchRoot(targetFilter)))
+	}
+	return validators
+}
@@ -0,0 +1,110 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const PackageResourceName = "package"
+
+// PackageFileExtensions are the package formats that the built-in package repository accepts. The longest extensions
+// come first so that a .tar.gz file isn't taken for a .gz file.
+var PackageFileExtensions = []string{".tar.bz2", ".tar.gz", ".nupkg", ".tar", ".tgz", ".zip", ".jar", ".war", ".ear"}
+
+var PackageOverwriteModes = []string{
+	string(packages.OverwriteModeFailIfExists),
+	string(packages.OverwriteModeOverwriteExisting),
+	string(packages.OverwriteModeIgnoreIfExists),
+}
+
+type PackageResourceModel struct {
+	SpaceID             types.String `tfsdk:"space_id"`
+	Source              types.String `tfsdk:"source"`
+	PackageID           types.String `tfsdk:"package_id"`
+	Version             types.String `tfsdk:"version"`
+	OverwriteMode       types.String `tfsdk:"overwrite_mode"`
+	UseDeltaCompression types.Bool   `tfsdk:"use_delta_compression"`
+	DeleteOnDestroy     types.Bool   `tfsdk:"delete_on_destroy"`
+	Sha256              types.String `tfsdk:"sha256"`
+	FileExtension       types.String `tfsdk:"file_extension"`
+	PackageSizeBytes    types.Int64  `tfsdk:"package_size_bytes"`
+
+	ResourceModel
+}
+
+type PackageSchema struct{}
+
+var _ EntitySchema = PackageSchema{}
+
+func (p PackageSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{
+		Description: "Uploads a package to the built-in package repository of a space in Octopus Deploy.",
+		Attributes: map[string]resourceSchema.Attribute{
+			"id": GetIdResourceSchema(),
+			"space_id": util.ResourceString().
+				Optional().
+				Computed().
+				PlanModifiers(stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()).
+				Description("The space ID associated with this package.").
+				Build(),
+			"source": util.ResourceString().
+				Required().
+				Validators(stringvalidator.LengthAtLeast(1)).
+				Description("The path of the package file to upload, relative to the working directory of Terraform. The supported formats are `.zip`, `.nupkg`, `.tar`, `.tar.gz`, `.tgz`, `.tar.bz2`, `.jar`, `.war` and `.ear`. A change to the content of the file replaces the package.").
+				Build(),
+			"package_id": util.ResourceString().
+				Optional().
+				Computed().
+				Validators(stringvalidator.LengthAtLeast(1), stringvalidator.AlsoRequires(path.MatchRoot("version"))).
+				Description("The ID of the package. Defaults to the ID in the name of the source file, which is named `<package_id>.<version><extension>`, e.g. `bootstrap.1.0.0.zip`.").
+				Build(),
+			"version": util.ResourceString().
+				Optional().
+				Computed().
+				Validators(stringvalidator.LengthAtLeast(1), stringvalidator.AlsoRequires(path.MatchRoot("package_id"))).
+				Description("The version of the package. Defaults to the version in the name of the source file.").
+				Build(),
+			"overwrite_mode": util.ResourceString().
+				Optional().
+				Computed().
+				Default(string(packages.OverwriteModeOverwriteExisting)).
+				Validators(stringvalidator.OneOf(PackageOverwriteModes...)).
+				Description("What to do when the version of the package is already in the repository: `FailIfExists`, `OverwriteExisting` or `IgnoreIfExists`. Defaults to `OverwriteExisting`, which lets a change to the file replace the package when `delete_on_destroy` isn't set.").
+				Build(),
+			"use_delta_compression": util.ResourceBool().
+				Optional().
+				Computed().
+				Default(true).
+				Description("Whether to upload only the difference from the previous version of the package, when the server supports it. Defaults to `true`.").
+				Build(),
+			"delete_on_destroy": util.ResourceBool().
+				Optional().
+				Computed().
+				Default(false).
+				Description("Whether to delete the package from the repository when the resource is destroyed or replaced. Defaults to `false`, which keeps the package for the releases that use it. A package that was overwritten since this resource uploaded it, e.g. by a replacement with the same package ID and version that was created first, is kept.").
+				Build(),
+			"sha256": util.ResourceString().
+				Computed().
+				Description("The hex encoded SHA-256 hash of the uploaded package file. Not set when the overwrite mode is `IgnoreIfExists` and the repository kept a different package, which this resource then doesn't replace or delete.").
+				Build(),
+			"file_extension": util.ResourceString().
+				Computed().
+				Description("The file extension of the package.").
+				Build(),
+			"package_size_bytes": util.ResourceInt64().
+				Computed().
+				Description("The size of the package in the repository, in bytes.").
+				Build(),
+		},
+	}
+}
+
+func (p PackageSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{}
+}
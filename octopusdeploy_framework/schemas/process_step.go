@@ -18,8 +18,11 @@ var _ EntitySchema = ProcessStepSchema{}
 
 const ProcessStepResourceName = "process_step"
 
+// ProcessStepScriptBodyPropertyKey is the execution property holding the body of an inline script.
+const ProcessStepScriptBodyPropertyKey = "Octopus.Action.Script.ScriptBody"
+
 func (p ProcessStepSchema) GetResourceSchema() resourceSchema.Schema {
-	return resourceSchema.Schema{
+	stepSchema := resourceSchema.Schema{
 		Description: "This resource manages a single step of a Runbook or Deployment Process in Octopus Deploy.",
 		Attributes: map[string]resourceSchema.Attribute{
 			"id":       GetIdResourceSchema(),
@@ -138,8 +141,22 @@ func (p ProcessStepSchema) GetResourceSchema() resourceSchema.Schema {
 				DefaultEmpty().
 				Validators(warnAboutReservedExecutionProperties()).
 				Build(),
+			"script_file": util.ResourceString().
+				Description("Path of a file holding the inline script of the step, relative to the working directory of Terraform. The script is sent as the `" + ProcessStepScriptBodyPropertyKey + "` execution property with line endings normalised to LF, and must not also be set in `execution_properties` or `run_script`.").
+				Optional().
+				Build(),
+			"script_sha256": util.ResourceString().
+				Description("The SHA-256 hash of the inline script of the step, with line endings normalised to LF.").
+				Computed().
+				Build(),
 		},
 	}
+
+	for _, block := range ProcessStepActionBlocks {
+		stepSchema.Attributes[block.Name] = resourceActionBlockAttribute(block)
+	}
+
+	return stepSchema
 }
 
 func (p ProcessStepSchema) GetDatasourceSchema() datasourceSchema.Schema {
@@ -172,10 +189,33 @@ type ProcessStepResourceModel struct {
 	PrimaryPackage       *ProcessStepPackageReferenceResourceModel `tfsdk:"primary_package"`
 	Packages             types.Map                                 `tfsdk:"packages"`
 	ExecutionProperties  types.Map                                 `tfsdk:"execution_properties"`
+	ScriptFile           types.String                              `tfsdk:"script_file"`
+	ScriptSha256         types.String                              `tfsdk:"script_sha256"`
+
+	RunScript            types.Object `tfsdk:"run_script"`
+	DeployKubernetesYaml types.Object `tfsdk:"deploy_kubernetes_yaml"`
+	HelmUpgrade          types.Object `tfsdk:"helm_upgrade"`
+	DeployPackage        types.Object `tfsdk:"deploy_package"`
+	ManualIntervention   types.Object `tfsdk:"manual_intervention"`
+	Email                types.Object `tfsdk:"email"`
+	DeployRelease        types.Object `tfsdk:"deploy_release"`
 
 	ResourceModel
 }
 
+// ActionBlocks returns the typed action blocks of the step by block name.
+func (m *ProcessStepResourceModel) ActionBlocks() map[string]*types.Object {
+	return map[string]*types.Object{
+		"run_script":             &m.RunScript,
+		"deploy_kubernetes_yaml": &m.DeployKubernetesYaml,
+		"helm_upgrade":           &m.HelmUpgrade,
+		"deploy_package":         &m.DeployPackage,
+		"manual_intervention":    &m.ManualIntervention,
+		"email":                  &m.Email,
+		"deploy_release":         &m.DeployRelease,
+	}
+}
+
 type ProcessStepActionContainerModel struct {
 	FeedID types.String `tfsdk:"feed_id"`
 	Image  types.String `tfsdk:"image"`
@@ -0,0 +1,601 @@
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProcessStepActionPropertyKind is the type of the attribute an execution property is configured with in a typed block.
+type ProcessStepActionPropertyKind int
+
+const (
+	// ProcessStepActionPropertyString is an execution property configured as is.
+	ProcessStepActionPropertyString ProcessStepActionPropertyKind = iota
+	// ProcessStepActionPropertyBool is an execution property holding "True" or "False".
+	ProcessStepActionPropertyBool
+	// ProcessStepActionPropertySet is an execution property holding a comma separated list, such as team IDs.
+	ProcessStepActionPropertySet
+)
+
+// ProcessStepActionProperty is an attribute of a typed block and the execution property it is mapped to.
+type ProcessStepActionProperty struct {
+	Attribute   string
+	Key         string
+	Kind        ProcessStepActionPropertyKind
+	Description string
+	Required    bool
+	Default     string
+	OneOf       []string
+
+	// RequiredWhen makes the attribute required when another attribute of the block has one of the values
+	RequiredWhen *ProcessStepActionPropertyCondition
+	// ProvidedBy is an attribute of the step that sets the execution property instead of the block when it is set
+	ProvidedBy string
+}
+
+type ProcessStepActionPropertyCondition struct {
+	Attribute string
+	Values    []string
+}
+
+// ProcessStepActionBlock is a typed block of a process step that configures the execution properties of an action type
+// with validated attributes instead of execution_properties.
+type ProcessStepActionBlock struct {
+	Name        string
+	ActionType  string
+	Description string
+	Properties  []ProcessStepActionProperty
+}
+
+var scriptSources = []string{"Inline", "Package", "GitRepository"}
+
+var runOnServerProperty = ProcessStepActionProperty{
+	Attribute:   "run_on_server",
+	Key:         "Octopus.Action.RunOnServer",
+	Kind:        ProcessStepActionPropertyBool,
+	Description: "Whether the step runs on a worker instead of on the deployment targets.",
+}
+
+// ProcessStepActionBlocks are the typed blocks of the process step resource.
+var ProcessStepActionBlocks = []ProcessStepActionBlock{
+	{
+		Name:        "run_script",
+		ActionType:  "Octopus.Script",
+		Description: "Configures a `Octopus.Script` step that runs a script.",
+		Properties: []ProcessStepActionProperty{
+			{
+				Attribute:   "script_source",
+				Key:         "Octopus.Action.Script.ScriptSource",
+				Description: "Where the script comes from, one of `Inline`, `Package` or `GitRepository`.",
+				Default:     "Inline",
+				OneOf:       scriptSources,
+			},
+			{
+				Attribute:    "syntax",
+				Key:          "Octopus.Action.Script.Syntax",
+				Description:  "The syntax of an inline script, one of `PowerShell`, `Bash`, `CSharp`, `FSharp` or `Python`.",
+				OneOf:        []string{"PowerShell", "Bash", "CSharp", "FSharp", "Python"},
+				RequiredWhen: &ProcessStepActionPropertyCondition{Attribute: "script_source", Values: []string{"Inline"}},
+			},
+			{
+				Attribute:    "script_body",
+				Key:          ProcessStepScriptBodyPropertyKey,
+				Description:  "The body of an inline script. Conflicts with `script_file`.",
+				RequiredWhen: &ProcessStepActionPropertyCondition{Attribute: "script_source", Values: []string{"Inline"}},
+				ProvidedBy:   "script_file",
+			},
+			{
+				Attribute:    "script_file_name",
+				Key:          "Octopus.Action.Script.ScriptFileName",
+				Description:  "The path of the script in the package or Git repository.",
+				RequiredWhen: &ProcessStepActionPropertyCondition{Attribute: "script_source", Values: []string{"Package", "GitRepository"}},
+			},
+			{
+				Attribute:   "script_parameters",
+				Key:         "Octopus.Action.Script.ScriptParameters",
+				Description: "The parameters passed to the script in the package or Git repository.",
+			},
+			runOnServerProperty,
+		},
+	},
+	{
+		Name:        "deploy_kubernetes_yaml",
+		ActionType:  "Octopus.KubernetesDeployRawYaml",
+		Description: "Configures a `Octopus.KubernetesDeployRawYaml` step that deploys Kubernetes resources from YAML.",
+		Properties: []ProcessStepActionProperty{
+			{
+				Attribute:   "script_source",
+				Key:         "Octopus.Action.Script.ScriptSource",
+				Description: "Where the YAML comes from, one of `Inline`, `Package` or `GitRepository`.",
+				Default:     "Inline",
+				OneOf:       scriptSources,
+			},
+			{
+				Attribute:    "yaml",
+				Key:          "Octopus.Action.KubernetesContainers.CustomResourceYaml",
+				Description:  "The inline YAML of the Kubernetes resources.",
+				RequiredWhen: &ProcessStepActionPropertyCondition{Attribute: "script_source", Values: []string{"Inline"}},
+			},
+			{
+				Attribute:    "yaml_file_paths",
+				Key:          "Octopus.Action.KubernetesContainers.CustomResourceYamlFileName",
+				Description:  "The paths of the YAML files in the package or Git repository, one glob pattern per line.",
+				RequiredWhen: &ProcessStepActionPropertyCondition{Attribute: "script_source", Values: []string{"Package", "GitRepository"}},
+			},
+			{
+				Attribute:   "namespace",
+				Key:         "Octopus.Action.KubernetesContainers.Namespace",
+				Description: "The namespace of the Kubernetes resources.",
+			},
+			{
+				Attribute:   "server_side_apply",
+				Key:         "Octopus.Action.Kubernetes.ServerSideApply.Enabled",
+				Kind:        ProcessStepActionPropertyBool,
+				Description: "Whether the resources are applied with server-side apply.",
+			},
+			{
+				Attribute:   "force_conflicts",
+				Key:         "Octopus.Action.Kubernetes.ServerSideApply.ForceConflicts",
+				Kind:        ProcessStepActionPropertyBool,
+				Description: "Whether server-side apply takes ownership of fields managed by other field managers.",
+			},
+			{
+				Attribute:   "wait_for_resources",
+				Key:         "Octopus.Action.Kubernetes.ResourceStatusCheck",
+				Kind:        ProcessStepActionPropertyBool,
+				Description: "Whether the step waits for the resources to be ready.",
+			},
+			{
+				Attribute:   "wait_for_jobs",
+				Key:         "Octopus.Action.Kubernetes.WaitForJobs",
+				Kind:        ProcessStepActionPropertyBool,
+				Description: "Whether the step waits for jobs to complete.",
+			},
+			{
+				Attribute:   "deployment_timeout",
+				Key:         "Octopus.Action.Kubernetes.DeploymentTimeout",
+				Description: "The number of seconds the step waits for the resources to be ready.",
+			},
+			runOnServerProperty,
+		},
+	},
+	{
+		Name:        "helm_upgrade",
+		ActionType:  "Octopus.HelmChartUpgrade",
+		Description: "Configures a `Octopus.HelmChartUpgrade` step that upgrades a Helm release with the chart of the primary package.",
+		Properties: []ProcessStepActionProperty{
+			{
+				Attribute:   "release_name",
+				Key:         "Octopus.Action.Helm.ReleaseName",
+				Description: "The name of the Helm release.",
+				Required:    true,
+			},
+			{
+				Attribute:   "namespace",
+				Key:         "Octopus.Action.Helm.Namespace",
+				Description: "The namespace of the Helm release.",
+			},
+			{
+				Attribute:   "client_version",
+				Key:         "Octopus.Action.Helm.ClientVersion",
+				Description: "The major version of the Helm client, `V2` or `V3`.",
+				OneOf:       []string{"V2", "V3"},
+			},
+			{
+				Attribute:   "reset_values",
+				Key:         "Octopus.Action.Helm.ResetValues",
+				Kind:        ProcessStepActionPropertyBool,
+				Description: "Whether the values of the previous release are reset.",
+			},
+			{
+				Attribute:   "yaml_values",
+				Key:         "Octopus.Action.Helm.YamlValues",
+				Description: "Inline YAML values of the release.",
+			},
+			{
+				Attribute:   "key_values",
+				Key:         "Octopus.Action.Helm.KeyValues",
+				Description: "Values of the release as a JSON object of keys and values.",
+			},
+			{
+				Attribute:   "additional_args",
+				Key:         "Octopus.Action.Helm.AdditionalArgs",
+				Description: "Additional arguments passed to `helm upgrade`.",
+			},
+			{
+				Attribute:   "timeout",
+				Key:         "Octopus.Action.Helm.Timeout",
+				Description: "The time `helm upgrade` waits for the release, such as `5m0s`.",
+			},
+			runOnServerProperty,
+		},
+	},
+	{
+		Name:        "deploy_package",
+		ActionType:  "Octopus.TentaclePackage",
+		Description: "Configures a `Octopus.TentaclePackage` step that deploys the primary package to the deployment targets.",
+		Properties: []ProcessStepActionProperty{
+			{
+				Attribute:   "enabled_features",
+				Key:         "Octopus.Action.EnabledFeatures",
+				Kind:        ProcessStepActionPropertySet,
+				Description: "The features enabled on the step, such as `Octopus.Features.JsonConfigurationVariables`.",
+			},
+			{
+				Attribute:   "custom_installation_directory",
+				Key:         "Octopus.Action.Package.CustomInstallationDirectory",
+				Description: "The directory the package is installed to instead of the default directory.",
+			},
+			{
+				Attribute:   "purge_custom_installation_directory",
+				Key:         "Octopus.Action.Package.CustomInstallationDirectoryShouldBePurgedBeforeDeployment",
+				Kind:        ProcessStepActionPropertyBool,
+				Description: "Whether the custom installation directory is purged before the package is installed.",
+			},
+			{
+				Attribute:   "skip_if_already_installed",
+				Key:         "Octopus.Action.Package.SkipIfAlreadyInstalled",
+				Kind:        ProcessStepActionPropertyBool,
+				Description: "Whether the package is skipped when the same version is already installed.",
+			},
+			{
+				Attribute:   "json_configuration_variables_targets",
+				Key:         "Octopus.Action.Package.JsonConfigurationVariablesTargets",
+				Description: "The JSON files in which variables are replaced, one glob pattern per line.",
+			},
+			{
+				Attribute:   "substitute_in_files_targets",
+				Key:         "Octopus.Action.SubstituteInFiles.TargetFiles",
+				Description: "The files in which variables are substituted, one glob pattern per line.",
+			},
+			{
+				Attribute:   "run_configuration_transformations",
+				Key:         "Octopus.Action.Package.AutomaticallyRunConfigurationTransformationFiles",
+				Kind:        ProcessStepActionPropertyBool,
+				Description: "Whether XML configuration transformation files are run.",
+			},
+			{
+				Attribute:   "update_app_settings_and_connection_strings",
+				Key:         "Octopus.Action.Package.AutomaticallyUpdateAppSettingsAndConnectionStrings",
+				Kind:        ProcessStepActionPropertyBool,
+				Description: "Whether appSettings and connectionStrings of XML configuration files are replaced by variables.",
+			},
+		},
+	},
+	{
+		Name:        "manual_intervention",
+		ActionType:  "Octopus.Manual",
+		Description: "Configures a `Octopus.Manual` step that pauses the deployment until a user approves it.",
+		Properties: []ProcessStepActionProperty{
+			{
+				Attribute:   "instructions",
+				Key:         "Octopus.Action.Manual.Instructions",
+				Description: "The instructions shown to the user.",
+				Required:    true,
+			},
+			{
+				Attribute:   "responsible_teams",
+				Key:         "Octopus.Action.Manual.ResponsibleTeamIds",
+				Kind:        ProcessStepActionPropertySet,
+				Description: "The IDs of the teams that can approve the step.",
+			},
+			{
+				Attribute:   "block_deployments",
+				Key:         "Octopus.Action.Manual.BlockConcurrentDeployments",
+				Kind:        ProcessStepActionPropertyBool,
+				Description: "Whether other deployments are blocked while the step waits.",
+			},
+		},
+	},
+	{
+		Name:        "email",
+		ActionType:  "Octopus.Email",
+		Description: "Configures a `Octopus.Email` step that sends an email.",
+		Properties: []ProcessStepActionProperty{
+			{
+				Attribute:   "to",
+				Key:         "Octopus.Action.Email.To",
+				Description: "The comma separated email addresses of the recipients.",
+			},
+			{
+				Attribute:   "to_teams",
+				Key:         "Octopus.Action.Email.ToTeamIds",
+				Kind:        ProcessStepActionPropertySet,
+				Description: "The IDs of the teams that receive the email.",
+			},
+			{
+				Attribute:   "cc",
+				Key:         "Octopus.Action.Email.CC",
+				Description: "The comma separated email addresses of the CC recipients.",
+			},
+			{
+				Attribute:   "cc_teams",
+				Key:         "Octopus.Action.Email.CCTeamIds",
+				Kind:        ProcessStepActionPropertySet,
+				Description: "The IDs of the teams that receive the email as CC recipients.",
+			},
+			{
+				Attribute:   "bcc",
+				Key:         "Octopus.Action.Email.Bcc",
+				Description: "The comma separated email addresses of the BCC recipients.",
+			},
+			{
+				Attribute:   "bcc_teams",
+				Key:         "Octopus.Action.Email.BccTeamIds",
+				Kind:        ProcessStepActionPropertySet,
+				Description: "The IDs of the teams that receive the email as BCC recipients.",
+			},
+			{
+				Attribute:   "subject",
+				Key:         "Octopus.Action.Email.Subject",
+				Description: "The subject of the email.",
+				Required:    true,
+			},
+			{
+				Attribute:   "body",
+				Key:         "Octopus.Action.Email.Body",
+				Description: "The body of the email.",
+				Required:    true,
+			},
+			{
+				Attribute:   "is_html",
+				Key:         "Octopus.Action.Email.IsHtml",
+				Kind:        ProcessStepActionPropertyBool,
+				Description: "Whether the body is HTML.",
+			},
+			{
+				Attribute:   "priority",
+				Key:         "Octopus.Action.Email.Priority",
+				Description: "The priority of the email, one of `Low`, `Normal` or `High`.",
+				OneOf:       []string{"Low", "Normal", "High"},
+			},
+		},
+	},
+	{
+		Name:        "deploy_release",
+		ActionType:  "Octopus.DeployRelease",
+		Description: "Configures a `Octopus.DeployRelease` step that deploys a release of another project.",
+		Properties: []ProcessStepActionProperty{
+			{
+				Attribute:   "project_id",
+				Key:         "Octopus.Action.DeployRelease.ProjectId",
+				Description: "The ID of the project whose release is deployed.",
+				Required:    true,
+			},
+			{
+				Attribute:   "deployment_condition",
+				Key:         "Octopus.Action.DeployRelease.DeploymentCondition",
+				Description: "When the release is deployed, one of `Always`, `IfNotCurrentVersion` or `IfNewer`.",
+				OneOf:       []string{"Always", "IfNotCurrentVersion", "IfNewer"},
+			},
+			{
+				Attribute:   "variables",
+				Key:         "Octopus.Action.DeployRelease.Variables",
+				Description: "The prompted variables of the deployment as a JSON object of names and values.",
+			},
+		},
+	},
+}
+
+// GetProcessStepActionBlock returns the typed block with a name.
+func GetProcessStepActionBlock(name string) (ProcessStepActionBlock, bool) {
+	for _, block := range ProcessStepActionBlocks {
+		if block.Name == name {
+			return block, true
+		}
+	}
+	return ProcessStepActionBlock{}, false
+}
+
+// AttributeTypes returns the types of the attributes of the block.
+func (b ProcessStepActionBlock) AttributeTypes() map[string]attr.Type {
+	attributeTypes := make(map[string]attr.Type, len(b.Properties))
+	for _, property := range b.Properties {
+		attributeTypes[property.Attribute] = property.attributeType()
+	}
+	return attributeTypes
+}
+
+// PropertyByKey returns the attribute of the block that configures an execution property.
+func (b ProcessStepActionBlock) PropertyByKey(key string) (ProcessStepActionProperty, bool) {
+	for _, property := range b.Properties {
+		if property.Key == key {
+			return property, true
+		}
+	}
+	return ProcessStepActionProperty{}, false
+}
+
+func (p ProcessStepActionProperty) attributeType() attr.Type {
+	switch p.Kind {
+	case ProcessStepActionPropertyBool:
+		return types.BoolType
+	case ProcessStepActionPropertySet:
+		return types.SetType{ElemType: types.StringType}
+	default:
+		return types.StringType
+	}
+}
+
+// NullValue returns the value of the attribute when the execution property is not set.
+func (p ProcessStepActionProperty) NullValue() attr.Value {
+	switch p.Kind {
+	case ProcessStepActionPropertyBool:
+		return types.BoolNull()
+	case ProcessStepActionPropertySet:
+		return types.SetNull(types.StringType)
+	default:
+		return types.StringNull()
+	}
+}
+
+func (p ProcessStepActionProperty) resourceAttribute() resourceSchema.Attribute {
+	description := fmt.Sprintf("%s Maps to the `%s` execution property.", p.Description, p.Key)
+
+	switch p.Kind {
+	case ProcessStepActionPropertyBool:
+		return util.ResourceBool().Description(description).Optional().Build()
+	case ProcessStepActionPropertySet:
+		return util.ResourceSet(types.StringType).Description(description).Optional().Build()
+	}
+
+	attribute := util.ResourceString().Description(description)
+	switch {
+	case p.Required:
+		attribute.Required()
+	case p.Default != "":
+		attribute.Optional().Computed().Default(p.Default)
+	default:
+		attribute.Optional()
+	}
+	if len(p.OneOf) > 0 {
+		attribute.Validators(stringvalidator.OneOf(p.OneOf...))
+	}
+	return attribute.Build()
+}
+
+func resourceActionBlockAttribute(block ProcessStepActionBlock) resourceSchema.SingleNestedAttribute {
+	attributes := make(map[string]resourceSchema.Attribute, len(block.Properties))
+	for _, property := range block.Properties {
+		attributes[property.Attribute] = property.resourceAttribute()
+	}
+
+	return resourceSchema.SingleNestedAttribute{
+		Description: fmt.Sprintf("%s Requires `type` to be `%s`. The execution properties of the block must not be set in `execution_properties`.", block.Description, block.ActionType),
+		Attributes:  attributes,
+		Optional:    true,
+		Validators:  []validator.Object{processStepActionBlockValidator{block: block}},
+	}
+}
+
+// processStepActionBlockValidator checks the attributes that are required depending on other attributes of a block.
+type processStepActionBlockValidator struct {
+	block ProcessStepActionBlock
+}
+
+func (v processStepActionBlockValidator) Description(_ context.Context) string {
+	return "attributes required by the configured values of other attributes must be set"
+}
+
+func (v processStepActionBlockValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v processStepActionBlockValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := req.ConfigValue.Attributes()
+	for _, property := range v.block.Properties {
+		condition := property.RequiredWhen
+		if condition == nil || !attributes[property.Attribute].IsNull() {
+			continue
+		}
+
+		conditionValue, ok := attributes[condition.Attribute].(types.String)
+		if !ok || conditionValue.IsUnknown() {
+			continue
+		}
+		value := conditionValue.ValueString()
+		if conditionValue.IsNull() {
+			conditionProperty, _ := v.block.PropertyByAttribute(condition.Attribute)
+			value = conditionProperty.Default
+		}
+
+		if slices.Contains(condition.Values, value) && !isProvidedByStepAttribute(ctx, req.Config, property) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName(property.Attribute),
+				"Missing required attribute",
+				fmt.Sprintf("The attribute %q is required when %q is %q.", property.Attribute, condition.Attribute, value),
+			)
+		}
+	}
+}
+
+// isProvidedByStepAttribute returns whether the execution property of an attribute is set by an attribute of the step.
+func isProvidedByStepAttribute(ctx context.Context, config tfsdk.Config, property ProcessStepActionProperty) bool {
+	if property.ProvidedBy == "" {
+		return false
+	}
+
+	var value types.String
+	if diags := config.GetAttribute(ctx, path.Root(property.ProvidedBy), &value); diags.HasError() {
+		return false
+	}
+	return !value.IsNull()
+}
+
+// PropertyByAttribute returns the attribute of the block with a name.
+func (b ProcessStepActionBlock) PropertyByAttribute(name string) (ProcessStepActionProperty, bool) {
+	for _, property := range b.Properties {
+		if property.Attribute == name {
+			return property, true
+		}
+	}
+	return ProcessStepActionProperty{}, false
+}
+
+// ProcessStepActionPropertyValue returns the execution property value of an attribute of a typed block, or false when
+// the attribute is not set.
+func ProcessStepActionPropertyValue(value attr.Value) (string, bool) {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return "", false
+	}
+
+	switch v := value.(type) {
+	case types.Bool:
+		if v.ValueBool() {
+			return "True", true
+		}
+		return "False", true
+	case types.Set:
+		items := make([]string, 0, len(v.Elements()))
+		for _, element := range v.Elements() {
+			if item, ok := element.(types.String); ok && !item.IsNull() && !item.IsUnknown() {
+				items = append(items, item.ValueString())
+			}
+		}
+		slices.Sort(items)
+		return strings.Join(items, ","), true
+	case types.String:
+		return v.ValueString(), true
+	}
+	return "", false
+}
+
+// ProcessStepActionAttributeValue returns the attribute value of a typed block for an execution property value, or
+// false when the value can not be represented by the attribute, such as a variable expression in a boolean property.
+func ProcessStepActionAttributeValue(property ProcessStepActionProperty, value string) (attr.Value, bool) {
+	switch property.Kind {
+	case ProcessStepActionPropertyBool:
+		switch strings.ToLower(value) {
+		case "true":
+			return types.BoolValue(true), true
+		case "false":
+			return types.BoolValue(false), true
+		}
+		return property.NullValue(), false
+	case ProcessStepActionPropertySet:
+		items := make([]attr.Value, 0)
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, types.StringValue(item))
+			}
+		}
+		return types.SetValueMust(types.StringType, items), true
+	default:
+		return types.StringValue(value), true
+	}
+}
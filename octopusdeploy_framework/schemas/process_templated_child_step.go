@@ -37,8 +37,17 @@ func (p ProcessTemplatedChildStepSchema) GetResourceSchema() resourceSchema.Sche
 				Required().
 				Build(),
 			"template_version": util.ResourceInt32().
-				Description("Version of the template this step will be based on.").
-				Required().
+				Description("Version of the template this step will be based on. Required unless `auto_upgrade` is enabled.").
+				Optional().
+				Computed().
+				Build(),
+			"auto_upgrade": util.ResourceBool().
+				Description("When enabled, the step is upgraded to the latest version of the template, which is planned as a change of `template_version`. " +
+					"Like the 'update step' action in Octopus, configured parameters keep their values, and parameters that are not configured get the default values of the new version. " +
+					"Conflicts with `template_version`.").
+				Optional().
+				Computed().
+				Default(false).
 				Build(),
 			"name": GetNameResourceSchema(true),
 			"type": util.ResourceString().
@@ -164,6 +173,7 @@ type ProcessTemplatedChildStepResourceModel struct {
 	ParentID        types.String `tfsdk:"parent_id"`
 	TemplateID      types.String `tfsdk:"template_id"`
 	TemplateVersion types.Int32  `tfsdk:"template_version"`
+	AutoUpgrade     types.Bool   `tfsdk:"auto_upgrade"`
 	Name            types.String `tfsdk:"name"`
 
 	Type                 types.String                     `tfsdk:"type"`
@@ -33,8 +33,17 @@ func (p ProcessTemplatedStepSchema) GetResourceSchema() resourceSchema.Schema {
 				Required().
 				Build(),
 			"template_version": util.ResourceInt32().
-				Description("Version of the template this step will be based on.").
-				Required().
+				Description("Version of the template this step will be based on. Required unless `auto_upgrade` is enabled.").
+				Optional().
+				Computed().
+				Build(),
+			"auto_upgrade": util.ResourceBool().
+				Description("When enabled, the step is upgraded to the latest version of the template, which is planned as a change of `template_version`. " +
+					"Like the 'update step' action in Octopus, configured parameters keep their values, and parameters that are not configured get the default values of the new version. " +
+					"Conflicts with `template_version`.").
+				Optional().
+				Computed().
+				Default(false).
 				Build(),
 			"name": GetNameResourceSchema(true),
 			"start_trigger": util.ResourceString().
@@ -181,6 +190,7 @@ type ProcessTemplatedStepResourceModel struct {
 	ProcessID          types.String `tfsdk:"process_id"`
 	TemplateID         types.String `tfsdk:"template_id"`
 	TemplateVersion    types.Int32  `tfsdk:"template_version"`
+	AutoUpgrade        types.Bool   `tfsdk:"auto_upgrade"`
 	Name               types.String `tfsdk:"name"`
 	StartTrigger       types.String `tfsdk:"start_trigger"`
 	PackageRequirement types.String `tfsdk:"package_requirement"`
@@ -2,7 +2,7 @@ package schemas
 
 import (
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -32,21 +32,22 @@ func (p ProjectSchema) GetResourceSchema() resourceSchema.Schema {
 			"description":                          GetDescriptionResourceSchema(ProjectResourceName),
 			"allow_deployments_to_no_targets":      util.ResourceBool().Optional().Deprecated("This value is only valid for an associated connectivity policy and should not be specified here.").Build(),
 			"auto_create_release":                  util.ResourceBool().Optional().Computed().PlanModifiers(boolplanmodifier.UseStateForUnknown()).Deprecated("This attribute is deprecated in favor of resource octopusdeploy_project_auto_create_release.").Build(),
-			"cloned_from_project_id":               util.ResourceString().Optional().Description("The ID of the project this project was cloned from.").Build(),
+			"clone_from_project_id":                util.ResourceString().Optional().PlanModifiers(stringplanmodifier.RequiresReplace()).Description("The ID of the project to clone when this project is created. The deployment process, runbooks, variables and channels of the project are copied, and the other attributes of this resource are then applied to the clone. The templates, connectivity policy, versioning strategy, release creation strategy, automatic release overrides and extension settings of the source project are kept, and aren't managed by this resource, while their blocks aren't configured. The steps of the cloned deployment process can be imported into `octopusdeploy_process_step` resources using `deployment_process_id`. The project is replaced when this changes.").Build(),
+			"cloned_from_project_id":               util.ResourceString().Optional().Computed().PlanModifiers(stringplanmodifier.UseStateForUnknown()).Description("The ID of the project this project was cloned from.").Build(),
 			"default_guided_failure_mode":          util.ResourceString().Optional().Computed().PlanModifiers(stringplanmodifier.UseStateForUnknown()).Build(),
 			"default_to_skip_if_already_installed": util.ResourceBool().Optional().Computed().PlanModifiers(boolplanmodifier.UseStateForUnknown()).Build(),
 			"deprovisioning_runbook_id":            util.ResourceString().Optional().Description("The ID of the runbook to run when deprovisioning an ephemeral environment for this project.").Build(),
 			"deployment_changes_template":          util.ResourceString().Optional().Computed().PlanModifiers(stringplanmodifier.UseStateForUnknown()).Build(),
-			"discrete_channel_release":             util.ResourceBool().Deprecated("use is_discrete_channel_release instead").Optional().Computed().PlanModifiers(boolplanmodifier.UseStateForUnknown()).Description("Treats releases of different channels to the same environment as a separate deployment dimension").Build(),
+			"discrete_channel_release":             util.ResourceBool().Deprecation(util.Deprecation{Replacement: "is_discrete_channel_release", RemovalVersion: "2.0.0", Forward: true}).Optional().Computed().PlanModifiers(boolplanmodifier.UseStateForUnknown()).Description("Treats releases of different channels to the same environment as a separate deployment dimension").Build(),
 			"is_disabled":                          util.ResourceBool().Optional().Computed().PlanModifiers(boolplanmodifier.UseStateForUnknown()).Build(),
-			"is_discrete_channel_release":          util.ResourceBool().Optional().Computed().PlanModifiers(boolplanmodifier.UseStateForUnknown()).Validators(stringvalidator.ConflictsWith(path.Expressions{path.MatchRoot("discrete_channel_release")}...)).Description("Treats releases of different channels to the same environment as a separate deployment dimension").Build(),
+			"is_discrete_channel_release":          util.ResourceBool().Optional().Computed().PlanModifiers(boolplanmodifier.UseStateForUnknown()).Validators(boolvalidator.ConflictsWith(path.Expressions{path.MatchRoot("discrete_channel_release")}...)).Description("Treats releases of different channels to the same environment as a separate deployment dimension").Build(),
 			"is_version_controlled":                util.ResourceBool().Optional().Computed().PlanModifiers(boolplanmodifier.UseStateForUnknown()).Build(),
 			"lifecycle_id":                         util.ResourceString().Required().Description("The lifecycle ID associated with this project.").Build(),
 			"project_group_id":                     util.ResourceString().Required().Description("The project group ID associated with this project.").Build(),
 			"provisioning_runbook_id":              util.ResourceString().Optional().Description("The ID of the runbook to run when provisioning an ephemeral environment for this project.").Build(),
 			"tenanted_deployment_participation":    util.ResourceString().Optional().Computed().PlanModifiers(stringplanmodifier.UseStateForUnknown()).Description("The tenanted deployment mode of the resource. Valid account types are `Untenanted`, `TenantedOrUntenanted`, or `Tenanted`.").Build(),
 			"included_library_variable_sets":       util.ResourceSet(types.StringType).Optional().Computed().PlanModifiers(setplanmodifier.UseStateForUnknown()).Description("The list of included library variable set IDs.").Build(),
-			"release_notes_template":               util.ResourceString().Optional().Computed().PlanModifiers(stringplanmodifier.UseStateForUnknown()).Build(),
+			"release_notes_template":               util.ResourceString().Optional().Computed().PlanModifiers(stringplanmodifier.UseStateForUnknown()).Description("The template used for the release notes of a new release. Variables that the template references are checked against the variables of the project when the plan is made, and undefined variables are reported as warnings.").Build(),
 			"slug":                                 util.ResourceString().Optional().Computed().PlanModifiers(stringplanmodifier.UseStateForUnknown()).Description("A human-readable, unique identifier, used to identify a project.").Build(),
 			"deployment_process_id":                util.ResourceString().Computed().PlanModifiers(stringplanmodifier.UseStateForUnknown()).Build(),
 			"variable_set_id":                      util.ResourceString().Computed().PlanModifiers(stringplanmodifier.UseStateForUnknown()).Build(),
@@ -0,0 +1,103 @@
+package schemas
+
+import (
+	"regexp"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	ProjectTenantConnectionsResourceDescription = "project tenant connections"
+	ProjectTenantConnectionsResourceName        = "project_tenant_connections"
+)
+
+type ProjectTenantConnectionsResourceModel struct {
+	SpaceID     types.String `tfsdk:"space_id"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	Tenants     types.Set    `tfsdk:"tenant"`
+	Rules       types.List   `tfsdk:"rule"`
+	Connections types.Map    `tfsdk:"connections"`
+
+	ResourceModel
+}
+
+type ProjectTenantConnectionModel struct {
+	TenantID       types.String `tfsdk:"tenant_id"`
+	EnvironmentIDs types.Set    `tfsdk:"environment_ids"`
+}
+
+type ProjectTenantConnectionRuleModel struct {
+	TenantTags     types.Set `tfsdk:"tenant_tags"`
+	EnvironmentIDs types.Set `tfsdk:"environment_ids"`
+}
+
+var canonicalTagNamePattern = regexp.MustCompile(`^[^/]+/[^/]+$`)
+
+// ProjectTenantConnectionsType is the type of the connections attribute, the environment IDs by tenant ID.
+var ProjectTenantConnectionsType = types.MapType{ElemType: types.SetType{ElemType: types.StringType}}
+
+type ProjectTenantConnectionsSchema struct{}
+
+var _ EntitySchema = ProjectTenantConnectionsSchema{}
+
+func (p ProjectTenantConnectionsSchema) GetResourceSchema() schema.Schema {
+	return schema.Schema{
+		Description: "Manages all the tenants connected to a project, and the environments they are connected to. " +
+			"Tenants connected to the project that are not in a `tenant` block and do not match a `rule` are disconnected. " +
+			"Do not use it together with `octopusdeploy_tenant_project` for the same project.",
+		Attributes: map[string]schema.Attribute{
+			"id":       GetIdResourceSchema(),
+			"space_id": GetSpaceIdResourceSchema(ProjectTenantConnectionsResourceDescription),
+			"project_id": util.ResourceString().
+				Required().
+				Description("The ID of the project.").
+				Validators(stringvalidator.LengthAtLeast(1)).
+				PlanModifiers(stringplanmodifier.RequiresReplace()).
+				Build(),
+			"connections": util.ResourceMap(types.SetType{ElemType: types.StringType}).
+				Computed().
+				Description("The environment IDs each tenant is connected to, by tenant ID. The rules are evaluated against the tenants of the space when the plan is made, so tenants that are connected or disconnected are shown in the plan.").
+				Build(),
+		},
+		Blocks: map[string]schema.Block{
+			"tenant": schema.SetNestedBlock{
+				Description: "Connects a tenant to the project.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tenant_id": GetRequiredStringResourceSchema("The ID of the tenant."),
+						"environment_ids": util.ResourceSet(types.StringType).
+							Required().
+							Description("The IDs of the environments the tenant is connected to.").
+							Build(),
+					},
+				},
+			},
+			"rule": schema.ListNestedBlock{
+				Description: "Connects the tenants with the tenant tags to the project. A tenant matches when it has at least one of the tags of each tag set in `tenant_tags`, as tenant tags are matched elsewhere in Octopus Deploy. The environments of all the rules and `tenant` blocks that include a tenant are combined.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tenant_tags": util.ResourceSet(types.StringType).
+							Required().
+							Description("The canonical names of the tenant tags, e.g. `Region/EU`.").
+							Validators(setvalidator.SizeAtLeast(1), setvalidator.ValueStringsAre(stringvalidator.RegexMatches(canonicalTagNamePattern, "must be a canonical tag name, e.g. 'Region/EU'"))).
+							Build(),
+						"environment_ids": util.ResourceSet(types.StringType).
+							Required().
+							Description("The IDs of the environments the tenants are connected to.").
+							Build(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (p ProjectTenantConnectionsSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{}
+}
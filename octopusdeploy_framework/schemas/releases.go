@@ -0,0 +1,94 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const ReleasesDataSourceName = "releases"
+
+type ReleasesDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	SpaceID         types.String `tfsdk:"space_id"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	ChannelID       types.String `tfsdk:"channel_id"`
+	SearchByVersion types.String `tfsdk:"search_by_version"`
+	VersionRange    types.String `tfsdk:"version_range"`
+	Latest          types.Bool   `tfsdk:"latest"`
+	Releases        types.List   `tfsdk:"releases"`
+}
+
+type ReleaseModel struct {
+	ID           types.String `tfsdk:"id"`
+	Version      types.String `tfsdk:"version"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	ChannelID    types.String `tfsdk:"channel_id"`
+	Assembled    types.String `tfsdk:"assembled"`
+	ReleaseNotes types.String `tfsdk:"release_notes"`
+}
+
+type ReleasesSchema struct{}
+
+var _ EntitySchema = ReleasesSchema{}
+
+func (s ReleasesSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{}
+}
+
+func (s ReleasesSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Description: "Provides information about the releases of a project, most recent first.",
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id":       GetIdDatasourceSchema(true),
+			"space_id": GetSpaceIdDatasourceSchema("releases", false),
+			"project_id": util.DataSourceString().
+				Required().
+				Description("The ID of the project of the releases.").
+				Build(),
+			"channel_id": util.DataSourceString().
+				Optional().
+				Description("Only returns the releases of a channel of the project.").
+				Build(),
+			"search_by_version": util.DataSourceString().
+				Optional().
+				Description("Only returns the releases whose version contains the text.").
+				Build(),
+			"version_range": util.DataSourceString().
+				Optional().
+				Description("Only returns the releases whose version is in a version range, in the NuGet version range syntax of channel version rules, e.g. `[1.0,2.0)`.").
+				Build(),
+			"latest": util.DataSourceBool().
+				Optional().
+				Description("Only returns the most recent release that matches the other filters.").
+				Build(),
+			"releases": datasourceSchema.ListNestedAttribute{
+				Description: "The releases, most recent first.",
+				Computed:    true,
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: map[string]datasourceSchema.Attribute{
+						"id":            util.DataSourceString().Computed().Description("The ID of the release.").Build(),
+						"version":       util.DataSourceString().Computed().Description("The version of the release.").Build(),
+						"project_id":    util.DataSourceString().Computed().Description("The ID of the project of the release.").Build(),
+						"channel_id":    util.DataSourceString().Computed().Description("The ID of the channel of the release.").Build(),
+						"assembled":     util.DataSourceString().Computed().Description("The time the release was created, in RFC 3339 format.").Build(),
+						"release_notes": util.DataSourceString().Computed().Description("The release notes of the release.").Build(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func ReleaseObjectType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":            types.StringType,
+		"version":       types.StringType,
+		"project_id":    types.StringType,
+		"channel_id":    types.StringType,
+		"assembled":     types.StringType,
+		"release_notes": types.StringType,
+	}
+}
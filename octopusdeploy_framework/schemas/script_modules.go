@@ -1,10 +1,12 @@
 package schemas
 
 import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -118,6 +120,16 @@ func ScriptObjectType() types.ObjectType {
 	}}
 }
 
+// ScriptModuleScriptResourceObjectType is the type of the script block of the script module resource.
+func ScriptModuleScriptResourceObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"body":          types.StringType,
+		"syntax":        types.StringType,
+		"script_file":   types.StringType,
+		"script_sha256": types.StringType,
+	}}
+}
+
 func ScriptModuleObjectType() map[string]attr.Type {
 	return map[string]attr.Type{
 		"description":     types.StringType,
@@ -148,8 +160,19 @@ func (s ScriptModuleSchema) GetResourceSchema() resourceSchema.Schema {
 				NestedObject: resourceSchema.NestedBlockObject{
 					Attributes: map[string]resourceSchema.Attribute{
 						"body": resourceSchema.StringAttribute{
-							Description: "The body of this script module.",
-							Required:    true,
+							Description: "The body of this script module. Exactly one of `body` or `script_file` must be set.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("script_file")),
+							},
+						},
+						"script_file": resourceSchema.StringAttribute{
+							Description: "Path of a file holding the body of this script module, relative to the working directory of Terraform. The body is sent with line endings normalised to LF.",
+							Optional:    true,
+						},
+						"script_sha256": resourceSchema.StringAttribute{
+							Description: "The SHA-256 hash of the body of this script module, with line endings normalised to LF.",
+							Computed:    true,
 						},
 						"syntax": resourceSchema.StringAttribute{
 							Description: "The syntax of the script. Valid types are `Bash`, `CSharp`, `FSharp`, `PowerShell`, or `Python`.",
@@ -208,8 +231,34 @@ func MapToScriptModuleFromState(data *ScriptModuleResourceModel, scriptModule *v
 	data.VariableSetId = types.StringValue(scriptModule.VariableSetID)
 	data.ID = types.StringValue(scriptModule.ID)
 
-	flattenScript(scriptModule)
+	// The body of a script loaded from a script file is not kept in the state, only its hash
+	scriptFile := types.StringNull()
+	if file, ok := data.ScriptAttributes()["script_file"].(types.String); ok {
+		scriptFile = file
+	}
+	body := types.StringValue(scriptModule.ScriptBody)
+	if !scriptFile.IsNull() {
+		body = types.StringNull()
+	}
 
-	var script, _ = types.ListValue(ScriptObjectType(), flattenScript(scriptModule))
+	var script, _ = types.ListValue(ScriptModuleScriptResourceObjectType(), []attr.Value{
+		types.ObjectValueMust(ScriptModuleScriptResourceObjectType().AttrTypes, map[string]attr.Value{
+			"body":          body,
+			"syntax":        types.StringValue(scriptModule.Syntax),
+			"script_file":   scriptFile,
+			"script_sha256": types.StringValue(util.ScriptSha256(scriptModule.ScriptBody)),
+		}),
+	})
 	data.Script = script
 }
+
+// ScriptAttributes returns the attributes of the script block, or nil when the script block is not known.
+func (m *ScriptModuleResourceModel) ScriptAttributes() map[string]attr.Value {
+	if m.Script.IsNull() || m.Script.IsUnknown() || len(m.Script.Elements()) == 0 {
+		return nil
+	}
+	if script, ok := m.Script.Elements()[0].(types.Object); ok {
+		return script.Attributes()
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const StepTemplateUsageDataSourceName = "step_template_usage"
+
+type StepTemplateUsageDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	SpaceID        types.String `tfsdk:"space_id"`
+	StepTemplateID types.String `tfsdk:"step_template_id"`
+	OutdatedOnly   types.Bool   `tfsdk:"outdated_only"`
+	LatestVersion  types.Int64  `tfsdk:"latest_version"`
+	Usages         types.List   `tfsdk:"usages"`
+}
+
+type StepTemplateUsageModel struct {
+	ProjectID       types.String `tfsdk:"project_id"`
+	ProjectName     types.String `tfsdk:"project_name"`
+	ProjectSlug     types.String `tfsdk:"project_slug"`
+	ProcessID       types.String `tfsdk:"process_id"`
+	ProcessType     types.String `tfsdk:"process_type"`
+	RunbookID       types.String `tfsdk:"runbook_id"`
+	RunbookName     types.String `tfsdk:"runbook_name"`
+	StepName        types.String `tfsdk:"step_name"`
+	ActionID        types.String `tfsdk:"action_id"`
+	ActionName      types.String `tfsdk:"action_name"`
+	Version         types.Int64  `tfsdk:"version"`
+	IsLatestVersion types.Bool   `tfsdk:"is_latest_version"`
+}
+
+type StepTemplateUsageSchema struct{}
+
+var _ EntitySchema = StepTemplateUsageSchema{}
+
+func (s StepTemplateUsageSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{}
+}
+
+func (s StepTemplateUsageSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Description: "Provides information about the deployment process and runbook steps that use a step template, with the version of the template each step is based on.",
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id":       GetIdDatasourceSchema(true),
+			"space_id": GetSpaceIdDatasourceSchema("step template", false),
+			"step_template_id": datasourceSchema.StringAttribute{
+				Description: "The ID of the step template.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"outdated_only": util.DataSourceBool().
+				Optional().
+				Description("Only returns the steps that are not based on the latest version of the step template.").
+				Build(),
+			"latest_version": util.DataSourceInt64().
+				Computed().
+				Description("The latest version of the step template.").
+				Build(),
+			"usages": datasourceSchema.ListNestedAttribute{
+				Description: "The steps that use the step template.",
+				Computed:    true,
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: map[string]datasourceSchema.Attribute{
+						"project_id":        util.DataSourceString().Computed().Description("The ID of the project of the step.").Build(),
+						"project_name":      util.DataSourceString().Computed().Description("The name of the project of the step.").Build(),
+						"project_slug":      util.DataSourceString().Computed().Description("The slug of the project of the step.").Build(),
+						"process_id":        util.DataSourceString().Computed().Description("The ID of the deployment process or runbook process of the step.").Build(),
+						"process_type":      util.DataSourceString().Computed().Description("The type of process of the step, `Deployment` or `Runbook`.").Build(),
+						"runbook_id":        util.DataSourceString().Computed().Description("The ID of the runbook of the step, when the step is in a runbook process.").Build(),
+						"runbook_name":      util.DataSourceString().Computed().Description("The name of the runbook of the step, when the step is in a runbook process.").Build(),
+						"step_name":         util.DataSourceString().Computed().Description("The name of the step.").Build(),
+						"action_id":         util.DataSourceString().Computed().Description("The ID of the action based on the step template.").Build(),
+						"action_name":       util.DataSourceString().Computed().Description("The name of the action based on the step template.").Build(),
+						"version":           util.DataSourceInt64().Computed().Description("The version of the step template the step is based on.").Build(),
+						"is_latest_version": util.DataSourceBool().Computed().Description("Whether the step is based on the latest version of the step template.").Build(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func StepTemplateUsageObjectType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"project_id":        types.StringType,
+		"project_name":      types.StringType,
+		"project_slug":      types.StringType,
+		"process_id":        types.StringType,
+		"process_type":      types.StringType,
+		"runbook_id":        types.StringType,
+		"runbook_name":      types.StringType,
+		"step_name":         types.StringType,
+		"action_id":         types.StringType,
+		"action_name":       types.StringType,
+		"version":           types.Int64Type,
+		"is_latest_version": types.BoolType,
+	}
+}
@@ -2,22 +2,28 @@ package schemas
 
 import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type TenantModel struct {
+	CloneFromTenantID  types.String `tfsdk:"clone_from_tenant_id"`
 	ClonedFromTenantId types.String `tfsdk:"cloned_from_tenant_id"`
 	Description        types.String `tfsdk:"description"`
 	IsDisabled         types.Bool   `tfsdk:"is_disabled"`
 	Name               types.String `tfsdk:"name"`
 	SpaceID            types.String `tfsdk:"space_id"`
+	TemplateTenantID   types.String `tfsdk:"template_tenant_id"`
 	TenantTags         types.Set    `tfsdk:"tenant_tags"`
 
 	ResourceModel
@@ -137,6 +143,17 @@ func (t TenantSchema) GetResourceSchema() resourceSchema.Schema {
 	return resourceSchema.Schema{
 		Description: "This resource manages tenants in Octopus Deploy.",
 		Attributes: map[string]resourceSchema.Attribute{
+			"clone_from_tenant_id": resourceSchema.StringAttribute{
+				Description: "The ID of the tenant to clone when this tenant is created. The project connections, tenant tags and variable values of the tenant are copied, and `is_disabled` and `tenant_tags` override the copied values when they are set. The description of the tenant is not copied, the tenant has `description`, which is empty when it is not set. The tenant is marked as a clone of the tenant, and is replaced when this changes.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("template_tenant_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"cloned_from_tenant_id": resourceSchema.StringAttribute{
 				Description: "The ID of the tenant from which this tenant was cloned.",
 				Optional:    true,
@@ -155,6 +172,13 @@ func (t TenantSchema) GetResourceSchema() resourceSchema.Schema {
 			},
 			"name":     GetNameResourceSchema(true),
 			"space_id": GetSpaceIdResourceSchema("tenant"),
+			"template_tenant_id": resourceSchema.StringAttribute{
+				Description: "The ID of a tenant to copy when this tenant is created, like `clone_from_tenant_id`. The tenant is not marked as a clone, and creating it fails if the server keeps it marked as one. Changes to this attribute after the tenant is created are ignored, so the template tenant can be changed or removed.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"tenant_tags": resourceSchema.SetAttribute{
 				Description: "A list of tenant tags associated with this resource.",
 				ElementType: types.StringType,
@@ -1,7 +1,9 @@
 package schemas
 
 import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 const (
@@ -27,6 +29,9 @@ func GetTenantCommonVariableResourceSchema() schema.Schema {
 		Blocks: map[string]schema.Block{
 			"scope": schema.ListNestedBlock{
 				Description: "Sets the scope of the variable.",
+				Validators: []validator.List{
+					util.RequiresFeature("CommonVariableScopingFeatureToggle"),
+				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"environment_ids": getEnvironmentsResourceSchema("A set of environment IDs to scope this variable to."),
@@ -5,6 +5,7 @@ import (
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -88,6 +89,9 @@ func (t TenantProjectVariableSchema) GetResourceSchema() schema.Schema {
 		Blocks: map[string]schema.Block{
 			"scope": schema.ListNestedBlock{
 				Description: "Sets the scope of the variable.",
+				Validators: []validator.List{
+					util.RequiresFeature("CommonVariableScopingFeatureToggle"),
+				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"environment_ids": getEnvironmentsResourceSchema("A set of environment IDs to scope this variable to."),
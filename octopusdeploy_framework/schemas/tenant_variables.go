@@ -0,0 +1,112 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	TenantVariablesResourceDescription = "tenant variables"
+	TenantVariablesResourceName        = "tenant_variables"
+)
+
+type TenantVariablesResourceModel struct {
+	SpaceID          types.String `tfsdk:"space_id"`
+	TenantID         types.String `tfsdk:"tenant_id"`
+	CommonVariables  types.Set    `tfsdk:"common_variable"`
+	ProjectVariables types.Set    `tfsdk:"project_variable"`
+
+	ResourceModel
+}
+
+type TenantVariablesCommonVariableModel struct {
+	LibraryVariableSetID types.String `tfsdk:"library_variable_set_id"`
+	TemplateID           types.String `tfsdk:"template_id"`
+	Value                types.String `tfsdk:"value"`
+}
+
+type TenantVariablesProjectVariableModel struct {
+	ProjectID     types.String `tfsdk:"project_id"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	TemplateID    types.String `tfsdk:"template_id"`
+	Value         types.String `tfsdk:"value"`
+}
+
+func TenantVariablesCommonVariableObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"library_variable_set_id": types.StringType,
+		"template_id":             types.StringType,
+		"value":                   types.StringType,
+	}}
+}
+
+func TenantVariablesProjectVariableObjectType() types.ObjectType {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"project_id":     types.StringType,
+		"environment_id": types.StringType,
+		"template_id":    types.StringType,
+		"value":          types.StringType,
+	}}
+}
+
+type TenantVariablesSchema struct{}
+
+var _ EntitySchema = TenantVariablesSchema{}
+
+func (t TenantVariablesSchema) GetResourceSchema() schema.Schema {
+	return schema.Schema{
+		Description: "Manages all the common and project variable values of a tenant, which are written in a single update. " +
+			"Values of the tenant that are not in a `common_variable` or `project_variable` block are removed, and templates without a default value must be given a value. Destroying the resource removes all the values of the tenant. " +
+			"Do not use it together with `octopusdeploy_tenant_common_variable` or `octopusdeploy_tenant_project_variable` for the same tenant.",
+		Attributes: map[string]schema.Attribute{
+			"id":       GetIdResourceSchema(),
+			"space_id": GetSpaceIdResourceSchema(TenantVariablesResourceDescription),
+			"tenant_id": util.ResourceString().
+				Required().
+				Description("The ID of the tenant.").
+				Validators(stringvalidator.LengthAtLeast(1)).
+				PlanModifiers(stringplanmodifier.RequiresReplace()).
+				Build(),
+		},
+		Blocks: map[string]schema.Block{
+			"common_variable": schema.SetNestedBlock{
+				Description: "The value of a common variable template of a library variable set included in a project the tenant is connected to.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"library_variable_set_id": GetRequiredStringResourceSchema("The ID of the library variable set."),
+						"template_id":             GetRequiredStringResourceSchema("The ID of the variable template."),
+						"value": util.ResourceString().
+							Required().
+							Sensitive().
+							Description("The value of the variable.").
+							Build(),
+					},
+				},
+			},
+			"project_variable": schema.SetNestedBlock{
+				Description: "The value of a project variable template of a project the tenant is connected to, for one of the environments the tenant is connected to the project in.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id":     GetRequiredStringResourceSchema("The ID of the project."),
+						"environment_id": GetRequiredStringResourceSchema("The ID of the environment."),
+						"template_id":    GetRequiredStringResourceSchema("The ID of the variable template."),
+						"value": util.ResourceString().
+							Required().
+							Sensitive().
+							Description("The value of the variable.").
+							Build(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (t TenantVariablesSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{}
+}
@@ -0,0 +1,176 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const TentacleBootstrapDataSourceName = "tentacle_bootstrap"
+
+type TentacleBootstrapModel struct {
+	ID                              types.String `tfsdk:"id"`
+	Name                            types.String `tfsdk:"name"`
+	SubscriptionID                  types.String `tfsdk:"subscription_id"`
+	Certificate                     types.String `tfsdk:"certificate"`
+	CertificateThumbprint           types.String `tfsdk:"certificate_thumbprint"`
+	ServerURL                       types.String `tfsdk:"server_url"`
+	ServerCommsPort                 types.Int64  `tfsdk:"server_comms_port"`
+	ServerThumbprint                types.String `tfsdk:"server_thumbprint"`
+	SpaceName                       types.String `tfsdk:"space_name"`
+	InstanceName                    types.String `tfsdk:"instance_name"`
+	Environments                    types.List   `tfsdk:"environments"`
+	Roles                           types.List   `tfsdk:"roles"`
+	Tenants                         types.List   `tfsdk:"tenants"`
+	TenantTags                      types.List   `tfsdk:"tenant_tags"`
+	TenantedDeploymentParticipation types.String `tfsdk:"tenanted_deployment_participation"`
+	MachinePolicy                   types.String `tfsdk:"machine_policy"`
+	WorkerPools                     types.List   `tfsdk:"worker_pools"`
+	SubscriptionURI                 types.String `tfsdk:"subscription_uri"`
+	Linux                           types.Object `tfsdk:"linux"`
+	Windows                         types.Object `tfsdk:"windows"`
+}
+
+type TentacleBootstrapPlatformModel struct {
+	ConfigPath       types.String `tfsdk:"config_path"`
+	TentacleConfig   types.String `tfsdk:"tentacle_config"`
+	ConfigureCommand types.String `tfsdk:"configure_command"`
+	RegisterCommand  types.String `tfsdk:"register_command"`
+	ServiceCommand   types.String `tfsdk:"service_command"`
+}
+
+type TentacleBootstrapSchema struct{}
+
+var _ EntitySchema = TentacleBootstrapSchema{}
+
+func (t TentacleBootstrapSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{}
+}
+
+func (t TentacleBootstrapSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Description: "Generates the configuration of a polling Tentacle, as a `Tentacle.config` file and as the equivalent Tentacle command lines, for Linux and Windows. Use it to pre-bake Tentacles into machine images without an interactive registration.",
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id":   util.DataSourceString().Computed().Description("The polling subscription URI of the Tentacle.").Build(),
+			"name": util.DataSourceString().Required().Description("The name of the deployment target or worker, as shown in Octopus Deploy.").Build(),
+			"subscription_id": util.DataSourceString().
+				Required().
+				Description("The polling subscription ID of the Tentacle, e.g. the `id` of `octopusdeploy_polling_subscription_id`. A `poll://` URI is accepted too.").
+				Build(),
+			"certificate": util.DataSourceString().
+				Required().
+				Sensitive().
+				Description("The base64 encoded pfx certificate of the Tentacle, e.g. the `base64` of `octopusdeploy_tentacle_certificate`.").
+				Build(),
+			"certificate_thumbprint": util.DataSourceString().
+				Required().
+				Description("The thumbprint of the Tentacle certificate, e.g. the `thumbprint` of `octopusdeploy_tentacle_certificate`.").
+				Build(),
+			"server_url": util.DataSourceString().
+				Optional().
+				Computed().
+				Description("The URL of the Octopus Server. Defaults to the address of the provider.").
+				Build(),
+			"server_comms_port": util.DataSourceInt64().
+				Optional().
+				Computed().
+				Description("The port the Tentacle polls the Octopus Server on. Defaults to `10943`.").
+				Build(),
+			"server_thumbprint": util.DataSourceString().
+				Optional().
+				Computed().
+				Description("The thumbprint of the Octopus Server certificate the Tentacle trusts. Defaults to the certificate of the Octopus Server the provider is connected to.").
+				Build(),
+			"space_name": util.DataSourceString().
+				Optional().
+				Computed().
+				Description("The name of the space the Tentacle is registered in. Defaults to the space of the provider.").
+				Build(),
+			"instance_name": util.DataSourceString().
+				Optional().
+				Computed().
+				Description("The name of the Tentacle instance. Defaults to `" + TentacleBootstrapDefaultInstanceName + "`.").
+				Build(),
+			"environments": util.DataSourceList(types.StringType).
+				Optional().
+				Description("The names of the environments the Tentacle is registered in as a deployment target.").
+				Build(),
+			"roles": util.DataSourceList(types.StringType).
+				Optional().
+				Description("The target tags the Tentacle is registered with as a deployment target.").
+				Build(),
+			"tenants": util.DataSourceList(types.StringType).
+				Optional().
+				Description("The names of the tenants the Tentacle is registered with as a deployment target.").
+				Build(),
+			"tenant_tags": util.DataSourceList(types.StringType).
+				Optional().
+				Description("The canonical names of the tenant tags the Tentacle is registered with as a deployment target.").
+				Build(),
+			"tenanted_deployment_participation": util.DataSourceString().
+				Optional().
+				Description("The tenanted deployment mode of the deployment target. One of `Untenanted`, `TenantedOrUntenanted` or `Tenanted`.").
+				Build(),
+			"machine_policy": util.DataSourceString().
+				Optional().
+				Description("The name of the machine policy the Tentacle is registered with.").
+				Build(),
+			"worker_pools": util.DataSourceList(types.StringType).
+				Optional().
+				Description("The names of the worker pools the Tentacle is registered in. Registers the Tentacle as a worker instead of a deployment target.").
+				Build(),
+			"subscription_uri": util.DataSourceString().
+				Computed().
+				Description("The polling subscription URI, the `tentacle_url` of a polling deployment target registered with the `octopusdeploy_polling_tentacle_deployment_target` resource.").
+				Build(),
+			"linux":   getTentacleBootstrapPlatformAttribute("Linux"),
+			"windows": getTentacleBootstrapPlatformAttribute("Windows"),
+		},
+	}
+}
+
+func getTentacleBootstrapPlatformAttribute(platform string) datasourceSchema.SingleNestedAttribute {
+	return datasourceSchema.SingleNestedAttribute{
+		Description: "The Tentacle configuration for " + platform + ".",
+		Computed:    true,
+		Sensitive:   true,
+		Attributes: map[string]datasourceSchema.Attribute{
+			"config_path": util.DataSourceString().
+				Computed().
+				Description("The path of the Tentacle configuration file.").
+				Build(),
+			"tentacle_config": util.DataSourceString().
+				Computed().
+				Description("The `Tentacle.config` file of a Tentacle that is already registered, e.g. with the `octopusdeploy_polling_tentacle_deployment_target` resource. It includes the certificate.").
+				Build(),
+			"configure_command": util.DataSourceString().
+				Computed().
+				Description("The Tentacle commands that create the instance from the configuration file at `config_path`, which `tentacle_config` is written to first, and configure it. The certificate is read from the configuration file.").
+				Build(),
+			"register_command": util.DataSourceString().
+				Computed().
+				Description("The Tentacle command that registers the Tentacle with the Octopus Server and trusts it, run after `configure_command` for Tentacles that are not registered with Terraform. It reads an API key from the `OCTOPUS_API_KEY` environment variable.").
+				Build(),
+			"service_command": util.DataSourceString().
+				Computed().
+				Description("The Tentacle command that installs and starts the Tentacle service.").
+				Build(),
+		},
+	}
+}
+
+const (
+	TentacleBootstrapDefaultInstanceName = "Tentacle"
+	TentacleBootstrapDefaultCommsPort    = 10943
+)
+
+func (t TentacleBootstrapSchema) GetDatasourceConfigValidators() []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(path.MatchRoot("worker_pools"), path.MatchRoot("environments")),
+		datasourcevalidator.Conflicting(path.MatchRoot("worker_pools"), path.MatchRoot("roles")),
+	}
+}
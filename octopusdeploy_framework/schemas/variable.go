@@ -140,6 +140,8 @@ func (v VariableSchema) GetDatasourceSchema() datasourceSchema.Schema {
 	}
 }
 
+var variableProjectIDDeprecation = util.Deprecation{Replacement: VariableSchemaAttributeNames.OwnerID, RemovalVersion: "2.0.0"}
+
 func (v VariableSchema) GetResourceSchema() resourceSchema.Schema {
 	return resourceSchema.Schema{
 		Description: util.GetResourceSchemaDescription(VariableResourceDescription),
@@ -155,10 +157,11 @@ func (v VariableSchema) GetResourceSchema() resourceSchema.Schema {
 				},
 			},
 			VariableSchemaAttributeNames.ProjectID: resourceSchema.StringAttribute{
-				DeprecationMessage: fmt.Sprintf("This attribute is deprecated; please use %s instead.", VariableSchemaAttributeNames.OwnerID),
+				DeprecationMessage: variableProjectIDDeprecation.Message(),
 				Optional:           true,
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName(VariableSchemaAttributeNames.OwnerID)),
+					variableProjectIDDeprecation,
 				},
 			},
 			VariableSchemaAttributeNames.IsEditable: resourceSchema.BoolAttribute{
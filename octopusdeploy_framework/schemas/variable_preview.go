@@ -0,0 +1,107 @@
+package schemas
+
+import (
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const VariablePreviewDataSourceName = "variable_preview"
+
+// VariablePreviewSensitiveValue is the value of a sensitive variable in a variable preview
+const VariablePreviewSensitiveValue = "********"
+
+type VariablePreviewDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	SpaceID       types.String `tfsdk:"space_id"`
+	ProjectID     types.String `tfsdk:"project_id"`
+	ChannelID     types.String `tfsdk:"channel_id"`
+	EnvironmentID types.String `tfsdk:"environment_id"`
+	TenantID      types.String `tfsdk:"tenant_id"`
+	MachineID     types.String `tfsdk:"machine_id"`
+	RunbookID     types.String `tfsdk:"runbook_id"`
+	Variables     types.List   `tfsdk:"variables"`
+	Values        types.Map    `tfsdk:"values"`
+}
+
+type VariablePreviewModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Value       types.String `tfsdk:"value"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	IsSensitive types.Bool   `tfsdk:"is_sensitive"`
+}
+
+type VariablePreviewSchema struct{}
+
+var _ EntitySchema = VariablePreviewSchema{}
+
+func (s VariablePreviewSchema) GetResourceSchema() resourceSchema.Schema {
+	return resourceSchema.Schema{}
+}
+
+func (s VariablePreviewSchema) GetDatasourceSchema() datasourceSchema.Schema {
+	return datasourceSchema.Schema{
+		Description: "Provides the variables that apply to a deployment or runbook run in a deployment context, as previewed by Octopus Deploy. The preview includes the variables of the project, of the library variable sets it includes and of the tenant, evaluated for the channel, environment, tenant and deployment target. The values of sensitive variables are masked.",
+		Attributes: map[string]datasourceSchema.Attribute{
+			"id":       GetIdDatasourceSchema(true),
+			"space_id": GetSpaceIdDatasourceSchema("variable preview", false),
+			"project_id": util.DataSourceString().
+				Required().
+				Description("The ID of the project to preview the variables of.").
+				Build(),
+			"channel_id": util.DataSourceString().
+				Optional().
+				Description("The ID of the channel of the deployment.").
+				Build(),
+			"environment_id": util.DataSourceString().
+				Optional().
+				Description("The ID of the environment of the deployment or runbook run.").
+				Build(),
+			"tenant_id": util.DataSourceString().
+				Optional().
+				Description("The ID of the tenant of the deployment or runbook run.").
+				Build(),
+			"machine_id": util.DataSourceString().
+				Optional().
+				Description("The ID of the deployment target the variables are evaluated for.").
+				Build(),
+			"runbook_id": util.DataSourceString().
+				Optional().
+				Description("The ID of the runbook to preview the variables of a run of. The variables of a deployment are previewed when no runbook is set.").
+				Build(),
+			"variables": datasourceSchema.ListNestedAttribute{
+				Description: "The variables that apply in the deployment context.",
+				Computed:    true,
+				NestedObject: datasourceSchema.NestedAttributeObject{
+					Attributes: map[string]datasourceSchema.Attribute{
+						"id":           util.DataSourceString().Computed().Description("The ID of the variable.").Build(),
+						"name":         util.DataSourceString().Computed().Description("The name of the variable.").Build(),
+						"value":        util.DataSourceString().Computed().Description("The value of the variable, or `" + VariablePreviewSensitiveValue + "` for a sensitive variable.").Build(),
+						"type":         util.DataSourceString().Computed().Description("The type of the variable.").Build(),
+						"description":  util.DataSourceString().Computed().Description("The description of the variable.").Build(),
+						"is_sensitive": util.DataSourceBool().Computed().Description("Whether the variable is sensitive.").Build(),
+					},
+				},
+			},
+			"values": util.DataSourceMap(types.StringType).
+				Computed().
+				Description("The values of the variables that apply in the deployment context, by variable name. The values of sensitive variables are `" + VariablePreviewSensitiveValue + "`.").
+				Build(),
+		},
+	}
+}
+
+func VariablePreviewObjectType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.StringType,
+		"name":         types.StringType,
+		"value":        types.StringType,
+		"type":         types.StringType,
+		"description":  types.StringType,
+		"is_sensitive": types.BoolType,
+	}
+}
@@ -0,0 +1,55 @@
+package schemas
+
+import (
+	"regexp"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/health"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type WaitForHealthyModel struct {
+	Timeout  types.String `tfsdk:"timeout"`
+	Statuses types.Set    `tfsdk:"statuses"`
+}
+
+func (WaitForHealthyModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"timeout":  types.StringType,
+		"statuses": types.SetType{ElemType: types.StringType},
+	}
+}
+
+var durationPattern = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
+
+// GetWaitForHealthyResourceBlock returns the wait_for_healthy block of deployment targets and workers.
+func GetWaitForHealthyResourceBlock() resourceSchema.ListNestedBlock {
+	return resourceSchema.ListNestedBlock{
+		Description: health.WaitForHealthyDescription,
+		NestedObject: resourceSchema.NestedBlockObject{
+			Attributes: map[string]resourceSchema.Attribute{
+				"timeout": util.ResourceString().
+					Optional().
+					Computed().
+					Default(health.DefaultTimeout).
+					Validators(stringvalidator.RegexMatches(durationPattern, "must be a duration such as 5m or 1h")).
+					Description(health.WaitForHealthyTimeoutDescription).
+					Build(),
+				"statuses": util.ResourceSet(types.StringType).
+					Optional().
+					Validators(setvalidator.ValueStringsAre(stringvalidator.OneOf(health.Statuses...))).
+					Description(health.WaitForHealthyStatusesDescription).
+					Build(),
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// appliedScriptPrivateStateKey is the private state key of the script last applied from a script file. Terraform only
+// shows a change of the hash of a script file, so the plan compares the file with this script to show the change.
+const appliedScriptPrivateStateKey = "applied_script"
+
+// privateState is the private state data of the requests and responses of a resource.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// readPlannedScriptFile reads the script file of a plan. The script is unknown when the path of the file is unknown and
+// null when no file is set.
+func readPlannedScriptFile(attributePath path.Path, scriptFile types.String) (types.String, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	if scriptFile.IsUnknown() {
+		return types.StringUnknown(), diags
+	}
+	if scriptFile.IsNull() {
+		return types.StringNull(), diags
+	}
+
+	script, err := util.ReadScriptFile(scriptFile.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attributePath, "Unable to read script file", err.Error())
+		return types.StringUnknown(), diags
+	}
+	return types.StringValue(script), diags
+}
+
+// readAppliedScriptFile reads the script file of a resource that is applied and checks that it is the script file the
+// plan was made with.
+func readAppliedScriptFile(scriptFile types.String, plannedSha256 types.String) (string, error) {
+	script, err := util.ReadScriptFile(scriptFile.ValueString())
+	if err != nil {
+		return "", err
+	}
+
+	if !plannedSha256.IsNull() && !plannedSha256.IsUnknown() && util.ScriptSha256(script) != plannedSha256.ValueString() {
+		return "", fmt.Errorf("the script file %s changed after the plan was made, run terraform plan again", scriptFile.ValueString())
+	}
+	return script, nil
+}
+
+// plannedScriptSha256 returns the hash of a planned script.
+func plannedScriptSha256(script types.String) types.String {
+	if script.IsNull() || script.IsUnknown() {
+		return script
+	}
+	return types.StringValue(util.ScriptSha256(script.ValueString()))
+}
+
+// setAppliedScript keeps the script applied from a script file in the private state, or removes it when the script is
+// not loaded from a file.
+func setAppliedScript(ctx context.Context, private privateState, scriptFile types.String, script string) diag.Diagnostics {
+	if scriptFile.IsNull() {
+		return private.SetKey(ctx, appliedScriptPrivateStateKey, nil)
+	}
+
+	value, err := json.Marshal(util.NormalizeScript(script))
+	if err != nil {
+		diags := diag.Diagnostics{}
+		diags.AddError("Unable to save the applied script", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, appliedScriptPrivateStateKey, value)
+}
+
+// warnAboutScriptFileChanges adds a warning with the lines that change between the applied script and a planned script file.
+func warnAboutScriptFileChanges(ctx context.Context, private privateState, attributePath path.Path, scriptFile types.String, script types.String) diag.Diagnostics {
+	if scriptFile.IsNull() || scriptFile.IsUnknown() || script.IsNull() || script.IsUnknown() {
+		return diag.Diagnostics{}
+	}
+
+	value, diags := private.GetKey(ctx, appliedScriptPrivateStateKey)
+	if diags.HasError() || value == nil {
+		return diags
+	}
+
+	var applied string
+	if err := json.Unmarshal(value, &applied); err != nil {
+		return diags // The diff is informational only
+	}
+
+	if diff := util.ScriptDiff(scriptFile.ValueString(), applied, script.ValueString()); diff != "" {
+		diags.AddAttributeWarning(attributePath, "Script file changed", fmt.Sprintf("The script loaded from %s changes:\n\n%s", scriptFile.ValueString(), diff))
+	}
+	return diags
+}
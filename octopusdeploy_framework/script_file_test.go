@@ -0,0 +1,120 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPrivateState map[string][]byte
+
+func (s testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return s[key], nil
+}
+
+func (s testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(s, key)
+	} else {
+		s[key] = value
+	}
+	return nil
+}
+
+func TestWarnAboutScriptFileChanges(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+	scriptFile := types.StringValue("scripts/deploy.sh")
+
+	require.False(t, setAppliedScript(ctx, private, scriptFile, "echo one\r\necho two\r\n").HasError())
+	assert.JSONEq(t, `"echo one\necho two\n"`, string(private[appliedScriptPrivateStateKey]))
+
+	diags := warnAboutScriptFileChanges(ctx, private, path.Root("script_file"), scriptFile, types.StringValue("echo one\necho two\n"))
+	assert.Empty(t, diags, "line endings are not a change")
+
+	diags = warnAboutScriptFileChanges(ctx, private, path.Root("script_file"), scriptFile, types.StringValue("echo one\necho three\n"))
+	require.Len(t, diags.Warnings(), 1)
+	assert.Contains(t, diags.Warnings()[0].Detail(), "--- scripts/deploy.sh (applied)\n+++ scripts/deploy.sh (planned)\n")
+	assert.Contains(t, diags.Warnings()[0].Detail(), "@@ -1,2 +1,2 @@\n echo one\n-echo two\n+echo three\n")
+
+	require.False(t, setAppliedScript(ctx, private, types.StringNull(), "echo one").HasError())
+	assert.Empty(t, private, "the applied script is only kept for script files")
+}
+
+func TestScriptDiff(t *testing.T) {
+	assert.Equal(t, "", util.ScriptDiff("deploy.sh", "echo one\r\n", "echo one\n"))
+	assert.Equal(t, "--- deploy.sh (applied)\n+++ deploy.sh (planned)\n@@ -1,3 +1,4 @@\n echo one\n-echo two\n+echo 2\n+echo 2.5\n echo three\n",
+		util.ScriptDiff("deploy.sh", "echo one\necho two\necho three\n", "echo one\necho 2\necho 2.5\necho three\n"))
+	assert.Equal(t, "--- deploy.sh (applied)\n+++ deploy.sh (planned)\n@@ -1,2 +1,3 @@\n echo one\n echo two\n+echo three\n",
+		util.ScriptDiff("deploy.sh", "echo one\necho two\n", "echo one\necho two\necho three\n"))
+	assert.Equal(t, "--- deploy.sh (applied)\n+++ deploy.sh (planned)\n@@ -1,2 +0,0 @@\n-echo one\n-echo two\n",
+		util.ScriptDiff("deploy.sh", "echo one\necho two\n", ""))
+	assert.Equal(t, "--- deploy.sh (applied)\n+++ deploy.sh (planned)\n\\ No newline at end of file\n",
+		util.ScriptDiff("deploy.sh", "echo one\n", "echo one"))
+}
+
+func TestScriptDiffSeparatedChanges(t *testing.T) {
+	var before, after strings.Builder
+	for i := 1; i <= 500; i++ {
+		fmt.Fprintf(&before, "echo %d\n", i)
+		switch i {
+		case 1:
+			after.WriteString("echo first\n")
+		case 250:
+		case 500:
+			fmt.Fprintf(&after, "echo %d\necho last\n", i)
+		default:
+			fmt.Fprintf(&after, "echo %d\n", i)
+		}
+	}
+
+	assert.Equal(t, `--- deploy.sh (applied)
++++ deploy.sh (planned)
+@@ -1,4 +1,4 @@
+-echo 1
++echo first
+ echo 2
+ echo 3
+ echo 4
+@@ -247,7 +247,6 @@
+ echo 247
+ echo 248
+ echo 249
+-echo 250
+ echo 251
+ echo 252
+ echo 253
+@@ -498,3 +497,4 @@
+ echo 498
+ echo 499
+ echo 500
++echo last
+`, util.ScriptDiff("deploy.sh", before.String(), after.String()))
+
+	rewritten := strings.ReplaceAll(before.String(), "echo", "Write-Host")
+	diff := util.ScriptDiff("deploy.sh", before.String(), rewritten)
+	assert.True(t, strings.HasPrefix(diff, "--- deploy.sh (applied)\n+++ deploy.sh (planned)\n@@ -1,500 +1,500 @@\n-echo 1\n"), "a rewritten script is shown as removed and added")
+	assert.Equal(t, 1000, strings.Count(diff, "\n")-3)
+}
+
+func TestReadAppliedScriptFile(t *testing.T) {
+	scriptFile := filepath.Join(t.TempDir(), "deploy.ps1")
+	require.NoError(t, os.WriteFile(scriptFile, []byte("\ufeffWrite-Host 'hello'\r\n"), 0o600))
+
+	script, err := readAppliedScriptFile(types.StringValue(scriptFile), types.StringValue(util.ScriptSha256("Write-Host 'hello'\n")))
+	require.NoError(t, err)
+	assert.Equal(t, "Write-Host 'hello'\n", script)
+
+	_, err = readAppliedScriptFile(types.StringValue(scriptFile), types.StringValue(util.ScriptSha256("Write-Host 'bye'\n")))
+	assert.ErrorContains(t, err, "changed after the plan was made")
+}
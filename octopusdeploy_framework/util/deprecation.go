@@ -0,0 +1,178 @@
+package util
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Deprecation describes an attribute or block that is replaced by another and will be removed from the resource.
+// It is added to the attribute validators, next to a deprecation message created with Message, so Terraform warns
+// about the attribute when it is set.
+//
+// An attribute with a ReversalKey is disabled, and fails validation when set, unless the key is listed in
+// TF_OCTOPUS_DEPRECATION_REVERSALS. With Forward, resources that call ForwardDeprecatedValues when planned copy the
+// value of the attribute to the replacement when only the deprecated attribute is set. The replacement must be a
+// sibling attribute of the same type that is optional and computed.
+type Deprecation struct {
+	Replacement    string
+	RemovalVersion string
+	ReversalKey    string
+	Forward        bool
+}
+
+var _ validator.String = Deprecation{}
+var _ validator.Bool = Deprecation{}
+var _ validator.Int32 = Deprecation{}
+var _ validator.Int64 = Deprecation{}
+var _ validator.Float64 = Deprecation{}
+var _ validator.Number = Deprecation{}
+var _ validator.List = Deprecation{}
+var _ validator.Set = Deprecation{}
+var _ validator.Map = Deprecation{}
+var _ validator.Object = Deprecation{}
+
+// Message is the deprecation message shown when the attribute is set and in the documentation.
+func (d Deprecation) Message() string {
+	return internal.GetDeprecatedAttributeMessage(d.Replacement, d.RemovalVersion, d.ReversalKey)
+}
+
+// IsDisabled reports whether setting the attribute is an error.
+func (d Deprecation) IsDisabled() bool {
+	return d.ReversalKey != "" && !internal.IsDeprecatedResourceEnabled(d.ReversalKey)
+}
+
+func (d Deprecation) Description(_ context.Context) string {
+	return d.Message()
+}
+
+func (d Deprecation) MarkdownDescription(ctx context.Context) string {
+	return d.Description(ctx)
+}
+
+func (d Deprecation) validate(p path.Path, value attr.Value, diags *diag.Diagnostics) {
+	if !d.IsDisabled() || !isConfigured(value) {
+		return
+	}
+
+	diags.AddAttributeError(p, "Deprecated attribute is disabled", internal.GetDeprecatedAttributeError(p.String(), d.ReversalKey).Error())
+}
+
+func (d Deprecation) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	d.validate(req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+func (d Deprecation) ValidateBool(_ context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	d.validate(req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+func (d Deprecation) ValidateInt32(_ context.Context, req validator.Int32Request, resp *validator.Int32Response) {
+	d.validate(req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+func (d Deprecation) ValidateInt64(_ context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	d.validate(req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+func (d Deprecation) ValidateFloat64(_ context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	d.validate(req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+func (d Deprecation) ValidateNumber(_ context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	d.validate(req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+func (d Deprecation) ValidateList(_ context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	d.validate(req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+func (d Deprecation) ValidateSet(_ context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	d.validate(req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+func (d Deprecation) ValidateMap(_ context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	d.validate(req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+func (d Deprecation) ValidateObject(_ context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	d.validate(req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+// SchemaDeprecation is a deprecated attribute or block found in a schema. Deprecation is nil for attributes that
+// only have a deprecation message.
+type SchemaDeprecation struct {
+	Expression  path.Expression
+	Message     string
+	Deprecation *Deprecation
+}
+
+// GetSchemaDeprecations finds the deprecated attributes and blocks of a resource schema, including nested attributes
+// and blocks.
+func GetSchemaDeprecations(s schema.Schema) []SchemaDeprecation {
+	var deprecations []SchemaDeprecation
+	for _, element := range walkSchema(s) {
+		found := SchemaDeprecation{Expression: element.Expression, Message: element.DeprecationMessage}
+		for _, v := range element.Validators {
+			if deprecation, ok := v.(Deprecation); ok {
+				found.Deprecation = &deprecation
+			}
+		}
+
+		if found.Message != "" || found.Deprecation != nil {
+			deprecations = append(deprecations, found)
+		}
+	}
+	return deprecations
+}
+
+// ForwardDeprecatedValues copies the configured values of deprecated attributes declared with Forward to their
+// replacements in the plan, unless the replacement is set as well.
+func ForwardDeprecatedValues(ctx context.Context, config tfsdk.Config, plan *tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceSchema, ok := config.Schema.(schema.Schema)
+	if !ok {
+		return diags
+	}
+
+	for _, deprecation := range GetSchemaDeprecations(resourceSchema) {
+		if deprecation.Deprecation == nil || !deprecation.Deprecation.Forward || deprecation.Deprecation.Replacement == "" {
+			continue
+		}
+
+		configured, configuredDiags := GetConfiguredPaths(ctx, config, deprecation.Expression)
+		diags.Append(configuredDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for _, deprecatedPath := range configured {
+			replacementPath := deprecatedPath.ParentPath().AtName(deprecation.Deprecation.Replacement)
+
+			replacement, replacementDiags := getConfigValue(ctx, config, replacementPath)
+			diags.Append(replacementDiags...)
+			if diags.HasError() {
+				return diags
+			}
+			if !replacement.IsNull() {
+				continue
+			}
+
+			value, valueDiags := getConfigValue(ctx, config, deprecatedPath)
+			diags.Append(valueDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			diags.Append(plan.SetAttribute(ctx, replacementPath, value)...)
+		}
+	}
+
+	return diags
+}
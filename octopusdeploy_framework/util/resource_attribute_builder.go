@@ -329,10 +329,36 @@ func (b *AttributeBuilder[T]) Validators(validators ...any) *AttributeBuilder[T]
 		if mapValidators, ok := convertToTypedSlice[validator.Map](validators); ok {
 			a.Validators = append(a.Validators, mapValidators...)
 		}
+	case *schema.NumberAttribute:
+		if numberValidators, ok := convertToTypedSlice[validator.Number](validators); ok {
+			a.Validators = append(a.Validators, numberValidators...)
+		}
+	case *schema.ObjectAttribute:
+		if objectValidators, ok := convertToTypedSlice[validator.Object](validators); ok {
+			a.Validators = append(a.Validators, objectValidators...)
+		}
 	}
 	return b
 }
 
+// MinServerVersion declares the Octopus Server version, e.g. '2025.2', needed to set the attribute.
+// Resources check it when planned with Config.EnsureAttributeCompatibility.
+func (b *AttributeBuilder[T]) MinServerVersion(version string) *AttributeBuilder[T] {
+	return b.Validators(MinServerVersion(version))
+}
+
+// RequiresFeature declares the Octopus Server feature toggle needed to set the attribute.
+// Resources check it when planned with Config.EnsureAttributeCompatibility.
+func (b *AttributeBuilder[T]) RequiresFeature(toggle string) *AttributeBuilder[T] {
+	return b.Validators(RequiresFeature(toggle))
+}
+
+// Deprecation marks the attribute as deprecated, naming its replacement and removal version in the deprecation
+// message. Resources forward values to the replacement with ForwardDeprecatedValues.
+func (b *AttributeBuilder[T]) Deprecation(deprecation Deprecation) *AttributeBuilder[T] {
+	return b.Deprecated(deprecation.Message()).Validators(deprecation)
+}
+
 func convertToTypedSlice[T any](slice []any) ([]T, bool) {
 	typedSlice := make([]T, 0, len(slice))
 	for _, item := range slice {
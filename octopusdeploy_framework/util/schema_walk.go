@@ -0,0 +1,106 @@
+package util
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// schemaElement is an attribute or block of a resource schema, with the expression matching it in the configuration.
+type schemaElement struct {
+	Expression         path.Expression
+	DeprecationMessage string
+	Validators         []any
+}
+
+// walkSchema lists the attributes and blocks of a resource schema, including nested attributes and blocks.
+func walkSchema(s schema.Schema) []schemaElement {
+	var elements []schemaElement
+	walkAttributes(path.MatchRoot, s.Attributes, &elements)
+	walkBlocks(path.MatchRoot, s.Blocks, &elements)
+	return elements
+}
+
+func walkAttributes(parent func(string) path.Expression, attributes map[string]schema.Attribute, elements *[]schemaElement) {
+	for name, attribute := range attributes {
+		expression := parent(name)
+		*elements = append(*elements, schemaElement{
+			Expression:         expression,
+			DeprecationMessage: attribute.GetDeprecationMessage(),
+			Validators:         attributeValidators(attribute),
+		})
+
+		switch a := attribute.(type) {
+		case schema.SingleNestedAttribute:
+			walkAttributes(expression.AtName, a.Attributes, elements)
+		case schema.ListNestedAttribute:
+			walkAttributes(expression.AtAnyListIndex().AtName, a.NestedObject.Attributes, elements)
+		case schema.SetNestedAttribute:
+			walkAttributes(expression.AtAnySetValue().AtName, a.NestedObject.Attributes, elements)
+		case schema.MapNestedAttribute:
+			walkAttributes(expression.AtAnyMapKey().AtName, a.NestedObject.Attributes, elements)
+		}
+	}
+}
+
+func walkBlocks(parent func(string) path.Expression, blocks map[string]schema.Block, elements *[]schemaElement) {
+	for name, block := range blocks {
+		expression := parent(name)
+
+		switch b := block.(type) {
+		case schema.SingleNestedBlock:
+			*elements = append(*elements, schemaElement{Expression: expression, DeprecationMessage: b.DeprecationMessage, Validators: toAny(b.Validators)})
+			walkAttributes(expression.AtName, b.Attributes, elements)
+			walkBlocks(expression.AtName, b.Blocks, elements)
+		case schema.ListNestedBlock:
+			*elements = append(*elements, schemaElement{Expression: expression, DeprecationMessage: b.DeprecationMessage, Validators: toAny(b.Validators)})
+			walkAttributes(expression.AtAnyListIndex().AtName, b.NestedObject.Attributes, elements)
+			walkBlocks(expression.AtAnyListIndex().AtName, b.NestedObject.Blocks, elements)
+		case schema.SetNestedBlock:
+			*elements = append(*elements, schemaElement{Expression: expression, DeprecationMessage: b.DeprecationMessage, Validators: toAny(b.Validators)})
+			walkAttributes(expression.AtAnySetValue().AtName, b.NestedObject.Attributes, elements)
+			walkBlocks(expression.AtAnySetValue().AtName, b.NestedObject.Blocks, elements)
+		}
+	}
+}
+
+func attributeValidators(attribute schema.Attribute) []any {
+	switch a := attribute.(type) {
+	case schema.StringAttribute:
+		return toAny(a.Validators)
+	case schema.BoolAttribute:
+		return toAny(a.Validators)
+	case schema.Int32Attribute:
+		return toAny(a.Validators)
+	case schema.Int64Attribute:
+		return toAny(a.Validators)
+	case schema.Float64Attribute:
+		return toAny(a.Validators)
+	case schema.NumberAttribute:
+		return toAny(a.Validators)
+	case schema.ListAttribute:
+		return toAny(a.Validators)
+	case schema.SetAttribute:
+		return toAny(a.Validators)
+	case schema.MapAttribute:
+		return toAny(a.Validators)
+	case schema.ObjectAttribute:
+		return toAny(a.Validators)
+	case schema.SingleNestedAttribute:
+		return toAny(a.Validators)
+	case schema.ListNestedAttribute:
+		return toAny(a.Validators)
+	case schema.SetNestedAttribute:
+		return toAny(a.Validators)
+	case schema.MapNestedAttribute:
+		return toAny(a.Validators)
+	}
+	return nil
+}
+
+func toAny[T any](slice []T) []any {
+	result := make([]any, len(slice))
+	for i, item := range slice {
+		result[i] = item
+	}
+	return result
+}
@@ -0,0 +1,222 @@
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// NormalizeScript returns a script with a leading byte order mark removed and Windows and classic Mac OS line endings
+// replaced by LF, so that a script has the same content and hash on every platform.
+func NormalizeScript(script string) string {
+	script = strings.TrimPrefix(script, "\ufeff")
+	script = strings.ReplaceAll(script, "\r\n", "\n")
+	return strings.ReplaceAll(script, "\r", "\n")
+}
+
+// ReadScriptFile reads a script from a file, relative to the working directory of Terraform, and normalises it.
+func ReadScriptFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read script file: %w", err)
+	}
+	return NormalizeScript(string(content)), nil
+}
+
+// ScriptSha256 returns the hex encoded SHA-256 hash of a normalised script.
+func ScriptSha256(script string) string {
+	hash := sha256.Sum256([]byte(NormalizeScript(script)))
+	return hex.EncodeToString(hash[:])
+}
+
+// scriptDiffContext is the number of unchanged lines shown around the changed lines of a script.
+const scriptDiffContext = 3
+
+// scriptDiffMaxEdits is the number of changed lines above which a script diff shows every line between the first and
+// the last change as removed and added, instead of finding the smallest set of changes.
+const scriptDiffMaxEdits = 1000
+
+// scriptLine is a line of a script diff, which is unchanged (' '), removed ('-') or added ('+').
+type scriptLine struct {
+	op   byte
+	text string
+}
+
+// ScriptDiff returns a unified diff of the lines that change between two versions of a script, or an empty string
+// when the normalised scripts are equal.
+func ScriptDiff(name string, before string, after string) string {
+	before, after = NormalizeScript(before), NormalizeScript(after)
+	if before == after {
+		return ""
+	}
+
+	lines := diffScriptLines(splitScriptLines(before), splitScriptLines(after))
+
+	var diff strings.Builder
+	fmt.Fprintf(&diff, "--- %s (applied)\n+++ %s (planned)\n", name, name)
+	if !slices.ContainsFunc(lines, func(line scriptLine) bool { return line.op != ' ' }) {
+		diff.WriteString("\\ No newline at end of file\n") // Only the line break at the end of the script changes
+		return diff.String()
+	}
+
+	// The line numbers before and after each line of the diff, to number the hunks
+	beforeLine, afterLine := make([]int, len(lines)+1), make([]int, len(lines)+1)
+	for i, line := range lines {
+		beforeLine[i+1], afterLine[i+1] = beforeLine[i], afterLine[i]
+		if line.op != '+' {
+			beforeLine[i+1]++
+		}
+		if line.op != '-' {
+			afterLine[i+1]++
+		}
+	}
+
+	for start := 0; start < len(lines); {
+		for start < len(lines) && lines[start].op == ' ' {
+			start++
+		}
+		if start == len(lines) {
+			break
+		}
+
+		// A hunk ends where more unchanged lines follow than the context of two hunks
+		end, unchanged := start, 0
+		for i := start; i < len(lines) && unchanged <= 2*scriptDiffContext; i++ {
+			if lines[i].op == ' ' {
+				unchanged++
+			} else {
+				end, unchanged = i+1, 0
+			}
+		}
+
+		from, to := max(start-scriptDiffContext, 0), min(end+scriptDiffContext, len(lines))
+		fmt.Fprintf(&diff, "@@ -%s +%s @@\n",
+			scriptDiffRange(beforeLine[from], beforeLine[to]-beforeLine[from]),
+			scriptDiffRange(afterLine[from], afterLine[to]-afterLine[from]))
+		for _, line := range lines[from:to] {
+			diff.WriteString(string(line.op) + line.text + "\n")
+		}
+		start = end
+	}
+	return diff.String()
+}
+
+// splitScriptLines splits a script into lines, where the line break at the end of the last line doesn't start a line.
+func splitScriptLines(script string) []string {
+	if script == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(script, "\n"), "\n")
+}
+
+// scriptDiffRange formats the lines of a hunk as the unified diff format does, where an empty hunk is numbered with the
+// line before it.
+func scriptDiffRange(start int, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	default:
+		return fmt.Sprintf("%d,%d", start+1, count)
+	}
+}
+
+// diffScriptLines finds the smallest set of removed and added lines that turns one version of a script into the other,
+// with the Myers diff algorithm.
+func diffScriptLines(before []string, after []string) []scriptLine {
+	n, m := len(before), len(after)
+	offset := n + m + 1
+	furthest := make([]int, 2*offset+1) // The furthest line of before reached on each diagonal k = x - y
+
+	// The furthest lines of the diagonals -d to d before each number of edits d, to trace the diff back
+	var trace [][]int
+	found := false
+	for d := 0; d <= min(n+m, scriptDiffMaxEdits) && !found; d++ {
+		trace = append(trace, slices.Clone(furthest[offset-d:offset+d+1]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && furthest[offset+k-1] < furthest[offset+k+1]) {
+				x = furthest[offset+k+1] // Add a line
+			} else {
+				x = furthest[offset+k-1] + 1 // Remove a line
+			}
+			y := x - k
+			for x < n && y < m && before[x] == after[y] {
+				x, y = x+1, y+1
+			}
+			furthest[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		return replaceScriptLines(before, after)
+	}
+
+	var lines []scriptLine
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		reached := func(k int) int { return trace[d][k+d] }
+		k := x - y
+		var previousK int
+		if k == -d || (k != d && reached(k-1) < reached(k+1)) {
+			previousK = k + 1
+		} else {
+			previousK = k - 1
+		}
+		previousX := 0
+		if d > 0 {
+			previousX = reached(previousK)
+		}
+		previousY := previousX - previousK
+
+		for x > previousX && y > previousY {
+			x, y = x-1, y-1
+			lines = append(lines, scriptLine{' ', before[x]})
+		}
+		if d > 0 {
+			if x == previousX {
+				lines = append(lines, scriptLine{'+', after[y-1]})
+			} else {
+				lines = append(lines, scriptLine{'-', before[x-1]})
+			}
+			x, y = previousX, previousY
+		}
+	}
+	slices.Reverse(lines)
+	return lines
+}
+
+// replaceScriptLines shows the lines between the first and the last changed line of a script as removed and added.
+func replaceScriptLines(before []string, after []string) []scriptLine {
+	first := 0
+	for first < len(before) && first < len(after) && before[first] == after[first] {
+		first++
+	}
+	last := 0
+	for last < len(before)-first && last < len(after)-first && before[len(before)-1-last] == after[len(after)-1-last] {
+		last++
+	}
+
+	lines := make([]scriptLine, 0, len(before)+len(after))
+	for _, line := range before[:first] {
+		lines = append(lines, scriptLine{' ', line})
+	}
+	for _, line := range before[first : len(before)-last] {
+		lines = append(lines, scriptLine{'-', line})
+	}
+	for _, line := range after[first : len(after)-last] {
+		lines = append(lines, scriptLine{'+', line})
+	}
+	for _, line := range before[len(before)-last:] {
+		lines = append(lines, scriptLine{' ', line})
+	}
+	return lines
+}
@@ -0,0 +1,159 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ServerRequirement is the Octopus Server version or feature toggle an attribute or block needs. It is added to the
+// attribute validators so it is declared next to the attribute, and checked against the connected server when the
+// resource is planned. The validation itself does nothing, as the server is not known when validating configuration.
+type ServerRequirement struct {
+	MinVersion string
+	Feature    string
+}
+
+var _ validator.String = ServerRequirement{}
+var _ validator.Bool = ServerRequirement{}
+var _ validator.Int32 = ServerRequirement{}
+var _ validator.Int64 = ServerRequirement{}
+var _ validator.Float64 = ServerRequirement{}
+var _ validator.Number = ServerRequirement{}
+var _ validator.List = ServerRequirement{}
+var _ validator.Set = ServerRequirement{}
+var _ validator.Map = ServerRequirement{}
+var _ validator.Object = ServerRequirement{}
+
+// MinServerVersion requires Octopus Server version, e.g. '2025.2', or later when the attribute is set.
+func MinServerVersion(version string) ServerRequirement {
+	return ServerRequirement{MinVersion: version}
+}
+
+// RequiresFeature requires the feature toggle to be enabled on the Octopus Server when the attribute is set.
+func RequiresFeature(toggle string) ServerRequirement {
+	return ServerRequirement{Feature: toggle}
+}
+
+func (r ServerRequirement) Description(_ context.Context) string {
+	if r.MinVersion != "" {
+		return fmt.Sprintf("requires Octopus Deploy server version %s or later", r.MinVersion)
+	}
+	return fmt.Sprintf("requires the '%s' feature toggle to be enabled on the Octopus Deploy server", r.Feature)
+}
+
+func (r ServerRequirement) MarkdownDescription(ctx context.Context) string {
+	return r.Description(ctx)
+}
+
+func (r ServerRequirement) ValidateString(context.Context, validator.StringRequest, *validator.StringResponse) {
+}
+
+func (r ServerRequirement) ValidateBool(context.Context, validator.BoolRequest, *validator.BoolResponse) {
+}
+
+func (r ServerRequirement) ValidateInt32(context.Context, validator.Int32Request, *validator.Int32Response) {
+}
+
+func (r ServerRequirement) ValidateInt64(context.Context, validator.Int64Request, *validator.Int64Response) {
+}
+
+func (r ServerRequirement) ValidateFloat64(context.Context, validator.Float64Request, *validator.Float64Response) {
+}
+
+func (r ServerRequirement) ValidateNumber(context.Context, validator.NumberRequest, *validator.NumberResponse) {
+}
+
+func (r ServerRequirement) ValidateList(context.Context, validator.ListRequest, *validator.ListResponse) {
+}
+
+func (r ServerRequirement) ValidateSet(context.Context, validator.SetRequest, *validator.SetResponse) {
+}
+
+func (r ServerRequirement) ValidateMap(context.Context, validator.MapRequest, *validator.MapResponse) {
+}
+
+func (r ServerRequirement) ValidateObject(context.Context, validator.ObjectRequest, *validator.ObjectResponse) {
+}
+
+// AttributeServerRequirement is a server requirement found in a schema, with the attributes it applies to.
+type AttributeServerRequirement struct {
+	Expression  path.Expression
+	Requirement ServerRequirement
+}
+
+// GetServerRequirements finds the server requirements declared on the attributes and blocks of a resource schema,
+// including nested attributes and blocks.
+func GetServerRequirements(s schema.Schema) []AttributeServerRequirement {
+	var requirements []AttributeServerRequirement
+	for _, element := range walkSchema(s) {
+		for _, v := range element.Validators {
+			if requirement, ok := v.(ServerRequirement); ok {
+				requirements = append(requirements, AttributeServerRequirement{Expression: element.Expression, Requirement: requirement})
+			}
+		}
+	}
+	return requirements
+}
+
+// GetConfiguredPaths returns the paths matching the expression that are set in the configuration. Null values,
+// empty collections and unknown values are not counted as set.
+func GetConfiguredPaths(ctx context.Context, config tfsdk.Config, expression path.Expression) (path.Paths, diag.Diagnostics) {
+	matches, diags := config.PathMatches(ctx, expression)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var configured path.Paths
+	for _, match := range matches {
+		value, valueDiags := getConfigValue(ctx, config, match)
+		diags.Append(valueDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if isConfigured(value) {
+			configured = append(configured, match)
+		}
+	}
+
+	return configured, diags
+}
+
+// getConfigValue returns the configured value at the path, typed by the schema.
+func getConfigValue(ctx context.Context, config tfsdk.Config, p path.Path) (attr.Value, diag.Diagnostics) {
+	attributeType, diags := config.Schema.TypeAtPath(ctx, p)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	target := reflect.New(reflect.TypeOf(attributeType.ValueType(ctx)))
+	diags.Append(config.GetAttribute(ctx, p, target.Interface())...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return target.Elem().Interface().(attr.Value), diags
+}
+
+func isConfigured(value attr.Value) bool {
+	if value.IsNull() || value.IsUnknown() {
+		return false
+	}
+
+	switch v := value.(type) {
+	case interface{ Elements() []attr.Value }:
+		return len(v.Elements()) > 0
+	case interface{ Elements() map[string]attr.Value }:
+		return len(v.Elements()) > 0
+	}
+
+	return true
+}
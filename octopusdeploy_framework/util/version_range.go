@@ -0,0 +1,146 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/hashicorp/go-version"
+)
+
+// VersionRange is a range of versions, e.g. the versions a channel version rule allows.
+type VersionRange struct {
+	intervals []versionInterval
+}
+
+// versionInterval is an interval of versions. A nil bound is unbounded.
+type versionInterval struct {
+	minimum          *version.Version
+	minimumInclusive bool
+	maximum          *version.Version
+	maximumInclusive bool
+}
+
+// ParseNuGetVersionRange parses a version range in the NuGet interval notation that Octopus Deploy uses for channel
+// version rules: 1.0 (at least 1.0), [1.0] (exactly 1.0), [1.0,2.0) (at least 1.0 and less than 2.0), (,2.0] (at most
+// 2.0) and so on.
+func ParseNuGetVersionRange(expression string) (*VersionRange, error) {
+	interval, err := parseVersionInterval(strings.TrimSpace(expression))
+	if err != nil {
+		return nil, fmt.Errorf("invalid version range %q: %w", expression, err)
+	}
+	return &VersionRange{intervals: []versionInterval{interval}}, nil
+}
+
+// parseVersionInterval parses an interval in the NuGet notation, in which a bare version is a minimum version.
+func parseVersionInterval(expression string) (versionInterval, error) {
+	if expression == "" {
+		return versionInterval{}, fmt.Errorf("the range is empty")
+	}
+
+	if !strings.ContainsAny(expression[:1], "[(") {
+		v, err := version.NewVersion(expression)
+		if err != nil {
+			return versionInterval{}, err
+		}
+		return versionInterval{minimum: v, minimumInclusive: true}, nil
+	}
+
+	if len(expression) < 3 || !strings.ContainsAny(expression[len(expression)-1:], "])") {
+		return versionInterval{}, fmt.Errorf("an interval starts with [ or ( and ends with ] or )")
+	}
+
+	interval := versionInterval{
+		minimumInclusive: expression[0] == '[',
+		maximumInclusive: expression[len(expression)-1] == ']',
+	}
+	bounds := strings.Split(expression[1:len(expression)-1], ",")
+	switch len(bounds) {
+	case 1:
+		// [1.0] is exactly 1.0
+		if !interval.minimumInclusive || !interval.maximumInclusive {
+			return versionInterval{}, fmt.Errorf("an exact version is written as [version]")
+		}
+		v, err := version.NewVersion(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return versionInterval{}, err
+		}
+		interval.minimum, interval.maximum = v, v
+	case 2:
+		for i, bound := range bounds {
+			bound = strings.TrimSpace(bound)
+			if bound == "" {
+				continue
+			}
+			v, err := version.NewVersion(bound)
+			if err != nil {
+				return versionInterval{}, err
+			}
+			if i == 0 {
+				interval.minimum = v
+			} else {
+				interval.maximum = v
+			}
+		}
+		if interval.minimum == nil && interval.maximum == nil {
+			return versionInterval{}, fmt.Errorf("an interval needs a minimum or a maximum version")
+		}
+	default:
+		return versionInterval{}, fmt.Errorf("an interval has a minimum and a maximum version")
+	}
+	return interval, nil
+}
+
+// Contains returns whether a version is in the range. A version that can't be parsed is not in any range.
+func (r *VersionRange) Contains(versionString string) bool {
+	v, err := version.NewVersion(versionString)
+	if err != nil {
+		return false
+	}
+
+	for _, interval := range r.intervals {
+		if interval.contains(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (i versionInterval) contains(v *version.Version) bool {
+	if i.minimum != nil {
+		if c := v.Compare(i.minimum); c < 0 || (c == 0 && !i.minimumInclusive) {
+			return false
+		}
+	}
+	if i.maximum != nil {
+		if c := v.Compare(i.maximum); c > 0 || (c == 0 && !i.maximumInclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+// SemVerRange is a range of versions in the SemVer constraint syntax of npm and Helm, e.g. 2.x, ^2.1 or >=1.2 <2.0.
+type SemVerRange struct {
+	constraints *semver.Constraints
+}
+
+// ParseSemVerRange parses a SemVer constraint. As in npm and Helm, a pre-release version is only in the range when the
+// constraint has a pre-release version, e.g. >=2.0.0-0, or when includePrerelease is set.
+func ParseSemVerRange(expression string, includePrerelease bool) (*SemVerRange, error) {
+	constraints, err := semver.NewConstraint(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SemVer range %q: %w", expression, err)
+	}
+	constraints.IncludePrerelease = includePrerelease
+	return &SemVerRange{constraints: constraints}, nil
+}
+
+// Contains returns whether a version is in the range. A version that can't be parsed is not in any range.
+func (r *SemVerRange) Contains(versionString string) bool {
+	v, err := semver.NewVersion(versionString)
+	if err != nil {
+		return false
+	}
+	return r.constraints.Check(v)
+}
@@ -0,0 +1,333 @@
+package octopusdeploy_framework
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/environments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// variableScopeField is a field of the scope of a variable, e.g. the environments a variable is scoped to.
+type variableScopeField struct {
+	attribute   string
+	description string
+	// projectOnly is set for the fields that only the variables of a project can be scoped to
+	projectOnly bool
+	// unresolvedHint explains the fields whose values are valid before any entity has them, e.g. target tags
+	unresolvedHint string
+	values         func(scope *variables.VariableScope) *[]string
+}
+
+var variableScopeFields = []variableScopeField{
+	{attribute: "environments", description: "environment", values: func(s *variables.VariableScope) *[]string { return &s.Environments }},
+	{attribute: "roles", description: "target tag", unresolvedHint: "The variable only applies once a deployment target has the tag.", values: func(s *variables.VariableScope) *[]string { return &s.Roles }},
+	{attribute: "machines", description: "deployment target", values: func(s *variables.VariableScope) *[]string { return &s.Machines }},
+	{attribute: "channels", description: "channel", projectOnly: true, values: func(s *variables.VariableScope) *[]string { return &s.Channels }},
+	{attribute: "actions", description: "step", projectOnly: true, values: func(s *variables.VariableScope) *[]string { return &s.Actions }},
+	{attribute: "processes", description: "process", projectOnly: true, values: func(s *variables.VariableScope) *[]string { return &s.ProcessOwners }},
+	{attribute: "tenant_tags", description: "tenant tag", values: func(s *variables.VariableScope) *[]string { return &s.TenantTags }},
+}
+
+// variableScopeEntity is an entity a variable can be scoped to.
+type variableScopeEntity struct {
+	ID   string
+	Name string
+	Slug string
+}
+
+func (e variableScopeEntity) matches(value string) bool {
+	return strings.EqualFold(e.ID, value) || strings.EqualFold(e.Name, value) || (e.Slug != "" && strings.EqualFold(e.Slug, value))
+}
+
+// variableScopeResolver resolves the values of the scope of a variable to the IDs of the entities of the owner of the
+// variable. The entities are the scope values of the variable set of the owner, which are loaded once per owner and
+// shared by the variables of the owner.
+type variableScopeResolver struct {
+	config   *Config
+	spaceID  string
+	ownerID  string
+	entities map[string][]variableScopeEntity
+	// cached is set when the entities were loaded for another variable, so they can miss an entity created since
+	cached bool
+}
+
+func newVariableScopeResolver(config *Config, spaceID string, ownerID string) *variableScopeResolver {
+	return &variableScopeResolver{config: config, spaceID: spaceID, ownerID: ownerID}
+}
+
+func isProjectVariableOwner(ownerID string) bool {
+	return strings.HasPrefix(ownerID, "Projects-")
+}
+
+func (r *variableScopeResolver) load() error {
+	if r.entities != nil {
+		return nil
+	}
+
+	if cached, ok := r.config.variableScopeEntities.Load(r.spaceID + "/" + r.ownerID); ok {
+		r.entities = cached.(map[string][]variableScopeEntity)
+		r.cached = true
+		return nil
+	}
+	return r.reload()
+}
+
+func (r *variableScopeResolver) reload() error {
+	client := r.config.Client
+	variableSet, err := variables.GetAll(client, r.spaceID, r.ownerID)
+	if err != nil {
+		return fmt.Errorf("unable to load the scope values of %s: %w", r.ownerID, err)
+	}
+
+	entities := map[string][]variableScopeEntity{}
+	if values := variableSet.ScopeValues; values != nil {
+		entities["environments"] = referenceDataEntities(values.Environments)
+		entities["roles"] = referenceDataEntities(values.Roles)
+		entities["machines"] = referenceDataEntities(values.Machines)
+		entities["channels"] = referenceDataEntities(values.Channels)
+		entities["actions"] = referenceDataEntities(values.Actions)
+		entities["tenant_tags"] = referenceDataEntities(values.TenantTags)
+		for _, process := range values.Processes {
+			entities["processes"] = append(entities["processes"], variableScopeEntity{ID: process.ID, Name: process.Name})
+		}
+	}
+
+	// The scope values have no slugs, the slugs of environments and of the project are loaded separately
+	if len(entities["environments"]) > 0 {
+		spaceEnvironments, err := environments.GetAll(client, r.spaceID)
+		if err != nil {
+			return fmt.Errorf("unable to load environments: %w", err)
+		}
+		for i, entity := range entities["environments"] {
+			if index := slices.IndexFunc(spaceEnvironments, func(e *environments.Environment) bool { return e.GetID() == entity.ID }); index >= 0 {
+				entities["environments"][i].Slug = spaceEnvironments[index].Slug
+			}
+		}
+	}
+	if isProjectVariableOwner(r.ownerID) {
+		project, err := projects.GetByID(client, r.spaceID, r.ownerID)
+		if err != nil {
+			return fmt.Errorf("unable to load project %s: %w", r.ownerID, err)
+		}
+		for i, entity := range entities["processes"] {
+			if entity.ID == project.GetID() {
+				entities["processes"][i].Slug = project.Slug
+			}
+		}
+	}
+
+	r.entities = entities
+	r.cached = false
+	r.config.variableScopeEntities.Store(r.spaceID+"/"+r.ownerID, entities)
+	return nil
+}
+
+func referenceDataEntities(items []*resources.ReferenceDataItem) []variableScopeEntity {
+	entities := make([]variableScopeEntity, 0, len(items))
+	for _, item := range items {
+		entities = append(entities, variableScopeEntity{ID: item.ID, Name: item.Name})
+	}
+	return entities
+}
+
+// resolve returns the entities of a scope field that a value is the ID, name or slug of. An ID takes precedence over
+// the names and slugs of other entities. The entities are loaded again when a value doesn't match the cached entities,
+// as the entity may have been created after they were loaded.
+func (r *variableScopeResolver) resolve(field variableScopeField, value string) ([]variableScopeEntity, error) {
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	matches := r.match(field, value)
+	if len(matches) == 0 && r.cached {
+		if err := r.reload(); err != nil {
+			return nil, err
+		}
+		matches = r.match(field, value)
+	}
+	return matches, nil
+}
+
+func (r *variableScopeResolver) match(field variableScopeField, value string) []variableScopeEntity {
+	candidates := r.entities[field.attribute]
+	if index := slices.IndexFunc(candidates, func(e variableScopeEntity) bool { return e.ID == value }); index >= 0 {
+		return candidates[index : index+1]
+	}
+
+	var matches []variableScopeEntity
+	for _, candidate := range candidates {
+		if candidate.matches(value) {
+			matches = append(matches, candidate)
+		}
+	}
+	return matches
+}
+
+func (r *variableScopeResolver) names(field variableScopeField) []string {
+	names := make([]string, 0, len(r.entities[field.attribute]))
+	for _, entity := range r.entities[field.attribute] {
+		names = append(names, entity.Name)
+	}
+	return names
+}
+
+// validateVariableScopeOwner checks that the variables of a library variable set are not scoped to the fields that
+// only the variables of a project can be scoped to.
+func validateVariableScopeOwner(ownerID types.String, scope types.List) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if ownerID.IsNull() || ownerID.IsUnknown() || isProjectVariableOwner(ownerID.ValueString()) || scope.IsNull() || scope.IsUnknown() || len(scope.Elements()) == 0 {
+		return diags
+	}
+
+	object, ok := scope.Elements()[0].(types.Object)
+	if !ok || object.IsUnknown() {
+		return diags
+	}
+
+	for _, field := range variableScopeFields {
+		if values, ok := object.Attributes()[field.attribute].(types.List); ok && field.projectOnly && !values.IsNull() {
+			diags.AddAttributeError(
+				path.Root("scope").AtListIndex(0).AtName(field.attribute),
+				"Invalid variable scope",
+				fmt.Sprintf("Only the variables of a project can be scoped to %s, %s is not a project.", field.attribute, ownerID.ValueString()),
+			)
+		}
+	}
+	return diags
+}
+
+// validateVariableScope resolves the values of the planned scope of a variable. A value that isn't the ID, name or slug
+// of an entity is a warning, as the entity may be created in the same apply, and a value that matches several entities
+// is an error.
+func validateVariableScope(resolver *variableScopeResolver, scope types.List) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if scope.IsNull() || scope.IsUnknown() || len(scope.Elements()) == 0 {
+		return diags
+	}
+
+	object, ok := scope.Elements()[0].(types.Object)
+	if !ok || object.IsUnknown() {
+		return diags
+	}
+
+	for _, field := range variableScopeFields {
+		values, ok := object.Attributes()[field.attribute].(types.List)
+		if !ok || values.IsNull() || values.IsUnknown() {
+			continue
+		}
+
+		for i, element := range values.Elements() {
+			value, ok := element.(types.String)
+			if !ok || value.IsNull() || value.IsUnknown() {
+				continue
+			}
+
+			valuePath := path.Root("scope").AtListIndex(0).AtName(field.attribute).AtListIndex(i)
+			matches, err := resolver.resolve(field, value.ValueString())
+			if err != nil {
+				diags.AddWarning("Unable to validate variable scope", err.Error())
+				return diags
+			}
+
+			switch len(matches) {
+			case 1:
+			case 0:
+				detail := fmt.Sprintf("%q is not the ID, name or slug of a %s that the variables of %s can be scoped to.", value.ValueString(), field.description, resolver.ownerID)
+				if suggestion := closestName(value.ValueString(), resolver.names(field)); suggestion != "" {
+					detail += fmt.Sprintf(" Did you mean %q?", suggestion)
+				}
+				if field.unresolvedHint != "" {
+					detail += " " + field.unresolvedHint
+				} else {
+					detail += fmt.Sprintf(" It is sent to Octopus Deploy as is, which rejects it unless the %s exists when the variable is applied.", field.description)
+				}
+				diags.AddAttributeWarning(valuePath, fmt.Sprintf("Unknown %s", field.description), detail)
+			default:
+				ids := make([]string, 0, len(matches))
+				for _, match := range matches {
+					ids = append(ids, match.ID)
+				}
+				diags.AddAttributeError(
+					valuePath,
+					fmt.Sprintf("Ambiguous %s", field.description),
+					fmt.Sprintf("%q matches the %ss %q. Use the ID of the %s instead.", value.ValueString(), field.description, ids, field.description),
+				)
+			}
+		}
+	}
+	return diags
+}
+
+// resolveVariableScope returns a scope with the names and slugs of the scope values replaced by the IDs of the
+// entities they resolve to. Values that don't resolve are kept, the server validates them.
+func resolveVariableScope(resolver *variableScopeResolver, scope variables.VariableScope) (variables.VariableScope, error) {
+	if scope.IsEmpty() {
+		return scope, nil
+	}
+
+	resolved := scope
+	for _, field := range variableScopeFields {
+		values := *field.values(&scope)
+		if len(values) == 0 {
+			continue
+		}
+
+		ids := make([]string, 0, len(values))
+		for _, value := range values {
+			matches, err := resolver.resolve(field, value)
+			if err != nil {
+				return scope, err
+			}
+			if len(matches) > 1 {
+				return scope, fmt.Errorf("the %s %q of the scope matches more than one %s, use the ID of the %s instead", field.description, value, field.description, field.description)
+			}
+			if len(matches) == 1 {
+				value = matches[0].ID
+			}
+			ids = append(ids, value)
+		}
+		*field.values(&resolved) = ids
+	}
+	return resolved, nil
+}
+
+// configuredVariableScope returns the scope of a variable to keep in the state. The configured values of a scope field
+// are kept when they resolve to the applied scope, so names and slugs don't show as a change to IDs in the plan.
+func configuredVariableScope(resolver *variableScopeResolver, configured types.List, applied variables.VariableScope) types.List {
+	scope := applied
+	if !configured.IsNull() && !configured.IsUnknown() {
+		configuredScope := schemas.MapToVariableScope(configured)
+		for _, field := range variableScopeFields {
+			configuredValues, appliedValues := *field.values(&configuredScope), *field.values(&applied)
+			if sameVariableScopeValues(configuredValues, appliedValues) {
+				*field.values(&scope) = configuredValues
+				continue
+			}
+
+			resolvedScope := variables.VariableScope{}
+			*field.values(&resolvedScope) = configuredValues
+			resolvedScope, err := resolveVariableScope(resolver, resolvedScope)
+			if err == nil && sameVariableScopeValues(*field.values(&resolvedScope), appliedValues) {
+				*field.values(&scope) = configuredValues
+			}
+		}
+	}
+
+	if scope.IsEmpty() {
+		return types.ListNull(types.ObjectType{AttrTypes: schemas.VariableScopeObjectType()})
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: schemas.VariableScopeObjectType()}, []attr.Value{schemas.MapFromVariableScope(scope)})
+}
+
+func sameVariableScopeValues(a []string, b []string) bool {
+	return len(a) == len(b) && !slices.ContainsFunc(a, func(value string) bool { return !slices.Contains(b, value) })
+}
@@ -0,0 +1,171 @@
+package octopusdeploy_framework
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVariableScopeResolver() *variableScopeResolver {
+	return &variableScopeResolver{
+		ownerID: "Projects-1",
+		entities: map[string][]variableScopeEntity{
+			"environments": {
+				{ID: "Environments-1", Name: "Development", Slug: "dev"},
+				{ID: "Environments-2", Name: "Production", Slug: "production"},
+				{ID: "Environments-3", Name: "Prod", Slug: "production-eu"},
+			},
+			"roles":       {{ID: "web-server", Name: "web-server"}},
+			"tenant_tags": {{ID: "Region/Europe", Name: "Region/Europe"}},
+			"processes": {
+				{ID: "Projects-1", Name: "Web", Slug: "web"},
+				{ID: "Runbooks-1", Name: "Restart"},
+			},
+		},
+	}
+}
+
+func testVariableScope(t *testing.T, fields map[string][]string) types.List {
+	attributes := map[string]attr.Value{}
+	for name, elementType := range schemas.VariableScopeObjectType() {
+		attributes[name] = types.ListNull(elementType.(types.ListType).ElemType)
+	}
+	for name, values := range fields {
+		elements := make([]attr.Value, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+		attributes[name] = types.ListValueMust(types.StringType, elements)
+	}
+
+	scope, diags := types.ListValue(
+		types.ObjectType{AttrTypes: schemas.VariableScopeObjectType()},
+		[]attr.Value{types.ObjectValueMust(schemas.VariableScopeObjectType(), attributes)},
+	)
+	require.False(t, diags.HasError())
+	return scope
+}
+
+func TestValidateVariableScope(t *testing.T) {
+	resolver := newTestVariableScopeResolver()
+
+	diags := validateVariableScope(resolver, testVariableScope(t, map[string][]string{
+		"environments": {"Environments-1", "production", "dev", "Developmnt", "production-eu", "Environments-99"},
+		"roles":        {"Web-Server", "database"},
+		"tenant_tags":  {"region/europe"},
+		"processes":    {"web", "Restart"},
+	}))
+
+	require.Len(t, diags, 3)
+	require.False(t, diags.HasError(), "unresolved values are sent to Octopus Deploy as they are")
+	require.Equal(t, "Unknown environment", diags.Warnings()[0].Summary())
+	require.Contains(t, diags.Warnings()[0].Detail(), `Did you mean "Development"?`)
+	require.Equal(t, "Unknown environment", diags.Warnings()[1].Summary())
+	require.Contains(t, diags.Warnings()[1].Detail(), `"Environments-99"`)
+	require.Contains(t, diags.Warnings()[1].Detail(), "unless the environment exists when the variable is applied")
+	require.Equal(t, "Unknown target tag", diags.Warnings()[2].Summary())
+	require.Contains(t, diags.Warnings()[2].Detail(), "once a deployment target has the tag")
+
+	ambiguous := validateVariableScope(resolver, testVariableScope(t, map[string][]string{"environments": {"Prod"}}))
+	require.Len(t, ambiguous, 0, "a name that is the name of one environment and the slug of none is not ambiguous")
+}
+
+func TestValidateVariableScopeAmbiguous(t *testing.T) {
+	resolver := newTestVariableScopeResolver()
+	resolver.entities["environments"] = append(resolver.entities["environments"], variableScopeEntity{ID: "Environments-4", Name: "Staging", Slug: "prod"})
+
+	diags := validateVariableScope(resolver, testVariableScope(t, map[string][]string{"environments": {"prod"}}))
+	require.Len(t, diags, 1)
+	require.Equal(t, "Ambiguous environment", diags[0].Summary())
+}
+
+func TestValidateVariableScopeOwner(t *testing.T) {
+	scope := testVariableScope(t, map[string][]string{"channels": {"Channels-1"}, "environments": {"Environments-1"}})
+
+	require.Empty(t, validateVariableScopeOwner(types.StringValue("Projects-1"), scope))
+	diags := validateVariableScopeOwner(types.StringValue("LibraryVariableSets-1"), scope)
+	require.Len(t, diags, 1)
+	require.Equal(t, path.Root("scope").AtListIndex(0).AtName("channels"), diags[0].(interface{ Path() path.Path }).Path())
+}
+
+func TestResolveVariableScope(t *testing.T) {
+	resolver := newTestVariableScopeResolver()
+
+	resolved, err := resolveVariableScope(resolver, variables.VariableScope{
+		Environments:  []string{"production", "Environments-1"},
+		Roles:         []string{"Web-Server", "database"},
+		TenantTags:    []string{"region/europe"},
+		ProcessOwners: []string{"Restart"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Environments-2", "Environments-1"}, resolved.Environments)
+	require.Equal(t, []string{"web-server", "database"}, resolved.Roles)
+	require.Equal(t, []string{"Region/Europe"}, resolved.TenantTags)
+	require.Equal(t, []string{"Runbooks-1"}, resolved.ProcessOwners)
+}
+
+func TestConfiguredVariableScope(t *testing.T) {
+	resolver := newTestVariableScopeResolver()
+	configured := testVariableScope(t, map[string][]string{"environments": {"Production", "dev"}, "roles": {"web-server"}})
+
+	scope := configuredVariableScope(resolver, configured, variables.VariableScope{
+		Environments: []string{"Environments-1", "Environments-2"},
+		Roles:        []string{"web-server"},
+	})
+	require.Equal(t, []string{"Production", "dev"}, schemas.MapToVariableScope(scope).Environments, "names that resolve to the applied scope are kept")
+
+	scope = configuredVariableScope(resolver, configured, variables.VariableScope{
+		Environments: []string{"Environments-3"},
+		Roles:        []string{"web-server"},
+	})
+	require.Equal(t, []string{"Environments-3"}, schemas.MapToVariableScope(scope).Environments, "a scope changed outside of Terraform is a change")
+
+	require.True(t, configuredVariableScope(resolver, types.ListNull(types.ObjectType{AttrTypes: schemas.VariableScopeObjectType()}), variables.VariableScope{}).IsNull())
+}
+
+func TestVariableScopeResolverCachesEntities(t *testing.T) {
+	roles := `[{"Id": "web-server", "Name": "web-server"}]`
+	requests := 0
+	octopus := newTestOctopusClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/Spaces-1/variables/variableset-LibraryVariableSets-1" {
+			http.NotFound(w, r)
+			return
+		}
+		requests++
+		_, _ = fmt.Fprintf(w, `{"Id": "variableset-LibraryVariableSets-1", "OwnerId": "LibraryVariableSets-1", "ScopeValues": {"Roles": %s}}`, roles)
+	})
+	config := &Config{Client: octopus}
+	rolesField := variableScopeFields[1]
+	require.Equal(t, "roles", rolesField.attribute)
+
+	matches, err := newVariableScopeResolver(config, "Spaces-1", "LibraryVariableSets-1").resolve(rolesField, "web-server")
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, 1, requests)
+
+	// The variables of the same owner resolve against the entities loaded for the first variable
+	resolver := newVariableScopeResolver(config, "Spaces-1", "LibraryVariableSets-1")
+	matches, err = resolver.resolve(rolesField, "Web-Server")
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, 1, requests)
+
+	// A value that doesn't match the cached entities loads them again, once
+	roles = `[{"Id": "web-server", "Name": "web-server"}, {"Id": "database", "Name": "database"}]`
+	matches, err = resolver.resolve(rolesField, "database")
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, 2, requests)
+
+	matches, err = resolver.resolve(rolesField, "cache")
+	require.NoError(t, err)
+	require.Empty(t, matches)
+	require.Equal(t, 2, requests)
+}
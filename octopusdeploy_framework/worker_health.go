@@ -0,0 +1,70 @@
+package octopusdeploy_framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/workers"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/health"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/octopusdeploy_framework/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// expandWaitForHealthy returns the options of the wait_for_healthy block, or nil when it is not set.
+func expandWaitForHealthy(ctx context.Context, waitForHealthy types.List) (*health.WaitOptions, diag.Diagnostics) {
+	if waitForHealthy.IsNull() || waitForHealthy.IsUnknown() || len(waitForHealthy.Elements()) == 0 {
+		return nil, nil
+	}
+
+	var models []schemas.WaitForHealthyModel
+	diags := waitForHealthy.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	timeout := models[0].Timeout.ValueString()
+	if timeout == "" {
+		timeout = health.DefaultTimeout
+	}
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		diags.AddError("invalid wait_for_healthy timeout", err.Error())
+		return nil, diags
+	}
+
+	options := &health.WaitOptions{Timeout: duration}
+	if !models[0].Statuses.IsNull() && !models[0].Statuses.IsUnknown() {
+		diags.Append(models[0].Statuses.ElementsAs(ctx, &options.AcceptedStatuses, false)...)
+	}
+
+	return options, diags
+}
+
+// waitForWorkerHealthy waits for the worker to become healthy when the wait_for_healthy block is set, and returns
+// the worker as read after the health check.
+func waitForWorkerHealthy(ctx context.Context, octopus *client.Client, worker *machines.Worker, waitForHealthy types.List) (*machines.Worker, diag.Diagnostics) {
+	options, diags := expandWaitForHealthy(ctx, waitForHealthy)
+	if options == nil || diags.HasError() {
+		return worker, diags
+	}
+
+	checked := worker
+	check := health.NewMachineCheck(octopus, worker.SpaceID, worker.GetID(), worker.Name, func() (health.MachineStatus, error) {
+		found, err := workers.GetByID(octopus, worker.SpaceID, worker.GetID())
+		if err != nil {
+			return health.MachineStatus{}, err
+		}
+		checked = found
+		return health.MachineStatus{HealthStatus: found.HealthStatus, StatusSummary: found.StatusSummary}, nil
+	})
+
+	if err := health.WaitForHealthy(ctx, check, *options); err != nil {
+		diags.AddError(fmt.Sprintf("worker '%s' did not become healthy", worker.Name), err.Error())
+	}
+
+	return checked, diags
+}